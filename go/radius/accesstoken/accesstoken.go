@@ -0,0 +1,169 @@
+// Package accesstoken provides typed bindings for the AccessTokenSystem contract, which implements tiered,
+// signature-verified access tokens on Radius. The contract source is available in the contracts/solidity directory
+// of the Radius SDK repository.
+package accesstoken
+
+import (
+	"context"
+	_ "embed" // Required to embed the AccessTokenSystem ABI
+	"fmt"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// abiJSON is the canonical AccessTokenSystem ABI
+//
+//go:embed AccessTokenSystem.abi
+var abiJSON string
+
+// ABI returns the canonical AccessTokenSystem ABI.
+//
+// @return The parsed AccessTokenSystem ABI
+func ABI() *radius.ABI {
+	return radius.ABIFromJSON(abiJSON)
+}
+
+// AccessTokenSystem wraps a deployed AccessTokenSystem contract with typed methods, so callers don't need to encode
+// method names and arguments by hand.
+type AccessTokenSystem struct {
+	// contract is the underlying AccessTokenSystem contract
+	contract *radius.Contract
+}
+
+// New creates an AccessTokenSystem bound to the contract deployed at the given address.
+//
+// @param address The address of the deployed AccessTokenSystem contract
+// @return A new AccessTokenSystem instance
+func New(address radius.Address) *AccessTokenSystem {
+	return &AccessTokenSystem{contract: radius.NewContract(address, ABI())}
+}
+
+// Contract returns the underlying contract, which can be used to call methods that have no typed wrapper.
+//
+// @return The underlying AccessTokenSystem contract
+func (a *AccessTokenSystem) Contract() *radius.Contract {
+	return a.contract
+}
+
+// CreateTier creates or replaces an access tier. Only the contract owner can create tiers.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The contract owner's signer
+// @param tierID The ID of the tier
+// @param price The price of the tier in wei
+// @param ttl The time to live of purchased access in seconds
+// @param active Whether the tier is available for purchase
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (a *AccessTokenSystem) CreateTier(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	tierID, price, ttl *big.Int,
+	active bool,
+) (*radius.Receipt, error) {
+	return a.contract.Execute(ctx, client, signer, "createTier", tierID, price, ttl, active)
+}
+
+// PurchaseAccess purchases access to a single tier. Any value sent above the tier price is refunded by the contract.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the account purchasing access
+// @param tierID The ID of the tier to purchase
+// @param value The amount paid for the tier in wei
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (a *AccessTokenSystem) PurchaseAccess(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	tierID, value *big.Int,
+) (*radius.Receipt, error) {
+	return a.contract.ExecuteWithValue(ctx, client, signer, value, "purchaseAccess", tierID)
+}
+
+// BatchPurchase purchases access to multiple tiers in a single transaction. The value must cover the total price of
+// all tiers, and any excess is refunded by the contract.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the account purchasing access
+// @param tierIDs The IDs of the tiers to purchase
+// @param value The total amount paid for the tiers in wei
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (a *AccessTokenSystem) BatchPurchase(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	tierIDs []*big.Int,
+	value *big.Int,
+) (*radius.Receipt, error) {
+	return a.contract.ExecuteWithValue(ctx, client, signer, value, "batchPurchaseAccess", tierIDs)
+}
+
+// IsValid returns whether the user holds valid, unexpired, and unrevoked access to the tier.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param user The address of the user to check
+// @param tierID The ID of the tier to check
+// @return Whether the access is valid and nil error on success
+// @return false and error if the contract call fails
+func (a *AccessTokenSystem) IsValid(
+	ctx context.Context,
+	client radius.ContractClient,
+	user radius.Address,
+	tierID *big.Int,
+) (bool, error) {
+	result, err := a.contract.Call(ctx, client, "isValid", user.EthAddress(), tierID)
+	if err != nil {
+		return false, err
+	}
+	return boolResult(result)
+}
+
+// VerifyAccess verifies a signed challenge off-chain, returning whether the signer of the challenge holds valid access
+// to the tier. The signature must be an EIP-191 signature of the challenge, as produced by Account.SignMessage.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param tierID The ID of the tier to check
+// @param challenge The challenge that was signed, used to prevent replay attacks
+// @param signature The signature of the challenge
+// @return Whether the signer holds valid access and nil error on success
+// @return false and error if the contract call fails
+func (a *AccessTokenSystem) VerifyAccess(
+	ctx context.Context,
+	client radius.ContractClient,
+	tierID *big.Int,
+	challenge string,
+	signature []byte,
+) (bool, error) {
+	result, err := a.contract.Call(ctx, client, "verifyAccess", tierID, challenge, signature)
+	if err != nil {
+		return false, err
+	}
+	return boolResult(result)
+}
+
+// boolResult returns the single boolean value decoded from a contract call result.
+//
+// @param result The decoded contract call result
+// @return The boolean value and nil error on success
+// @return false and error if the result is not a single boolean value
+func boolResult(result []interface{}) (bool, error) {
+	if len(result) != 1 {
+		return false, fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	value, ok := result[0].(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return value, nil
+}
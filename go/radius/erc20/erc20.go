@@ -0,0 +1,241 @@
+// Package erc20 provides typed bindings for ERC-20 token contracts on Radius, so callers can read balances and
+// transfer tokens without hand-rolling the ERC-20 ABI.
+package erc20
+
+import (
+	"context"
+	_ "embed" // Required to embed the ERC-20 ABI
+	"fmt"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// abiJSON is the standard ERC-20 ABI
+//
+//go:embed ERC20.abi
+var abiJSON string
+
+// ABI returns the standard ERC-20 ABI.
+//
+// @return The parsed ERC-20 ABI
+func ABI() *radius.ABI {
+	return radius.ABIFromJSON(abiJSON)
+}
+
+// Token wraps a deployed ERC-20 token contract with typed methods, so callers don't need to encode method names and
+// arguments or decode results by hand.
+type Token struct {
+	// contract is the underlying ERC-20 contract
+	contract *radius.Contract
+}
+
+// New creates a Token bound to the ERC-20 contract deployed at the given address.
+//
+// @param address The address of the deployed ERC-20 contract
+// @return A new Token instance
+func New(address radius.Address) *Token {
+	return &Token{contract: radius.NewContract(address, ABI())}
+}
+
+// Contract returns the underlying contract, which can be used to call methods that have no typed wrapper.
+//
+// @return The underlying ERC-20 contract
+func (t *Token) Contract() *radius.Contract {
+	return t.contract
+}
+
+// Allowance returns the amount of tokens the spender is allowed to transfer on behalf of the owner.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param owner The address of the token owner
+// @param spender The address of the approved spender
+// @return The remaining allowance in the token's smallest unit and nil error on success
+// @return nil and error if the contract call fails
+func (t *Token) Allowance(
+	ctx context.Context,
+	client radius.ContractClient,
+	owner, spender radius.Address,
+) (*big.Int, error) {
+	result, err := t.contract.Call(ctx, client, "allowance", owner.EthAddress(), spender.EthAddress())
+	if err != nil {
+		return nil, err
+	}
+	return bigResult(result)
+}
+
+// Approve allows the spender to transfer up to the given amount of the signer's tokens.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the token owner
+// @param spender The address of the spender to approve
+// @param amount The allowance in the token's smallest unit
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (t *Token) Approve(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	spender radius.Address,
+	amount *big.Int,
+) (*radius.Receipt, error) {
+	return t.contract.Execute(ctx, client, signer, "approve", spender.EthAddress(), amount)
+}
+
+// BalanceOf returns the token balance of the given account.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param account The address of the account to check
+// @return The balance in the token's smallest unit and nil error on success
+// @return nil and error if the contract call fails
+func (t *Token) BalanceOf(ctx context.Context, client radius.ContractClient, account radius.Address) (*big.Int, error) {
+	result, err := t.contract.Call(ctx, client, "balanceOf", account.EthAddress())
+	if err != nil {
+		return nil, err
+	}
+	return bigResult(result)
+}
+
+// Decimals returns the number of decimals used to display token amounts (e.g. 18).
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @return The number of decimals and nil error on success
+// @return 0 and error if the contract call fails
+func (t *Token) Decimals(ctx context.Context, client radius.ContractClient) (uint8, error) {
+	result, err := t.contract.Call(ctx, client, "decimals")
+	if err != nil {
+		return 0, err
+	}
+	if len(result) != 1 {
+		return 0, fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	value, ok := result[0].(uint8)
+	if !ok {
+		return 0, fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return value, nil
+}
+
+// Name returns the name of the token.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @return The token name and nil error on success
+// @return Empty string and error if the contract call fails
+func (t *Token) Name(ctx context.Context, client radius.ContractClient) (string, error) {
+	result, err := t.contract.Call(ctx, client, "name")
+	if err != nil {
+		return "", err
+	}
+	return stringResult(result)
+}
+
+// Symbol returns the ticker symbol of the token.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @return The token symbol and nil error on success
+// @return Empty string and error if the contract call fails
+func (t *Token) Symbol(ctx context.Context, client radius.ContractClient) (string, error) {
+	result, err := t.contract.Call(ctx, client, "symbol")
+	if err != nil {
+		return "", err
+	}
+	return stringResult(result)
+}
+
+// TotalSupply returns the total amount of tokens in existence.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @return The total supply in the token's smallest unit and nil error on success
+// @return nil and error if the contract call fails
+func (t *Token) TotalSupply(ctx context.Context, client radius.ContractClient) (*big.Int, error) {
+	result, err := t.contract.Call(ctx, client, "totalSupply")
+	if err != nil {
+		return nil, err
+	}
+	return bigResult(result)
+}
+
+// Transfer transfers the given amount of the signer's tokens to the recipient.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the token holder
+// @param to The address of the recipient
+// @param amount The amount to transfer in the token's smallest unit
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (t *Token) Transfer(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	to radius.Address,
+	amount *big.Int,
+) (*radius.Receipt, error) {
+	return t.contract.Execute(ctx, client, signer, "transfer", to.EthAddress(), amount)
+}
+
+// TransferFrom transfers the given amount of tokens from one account to another, using the allowance granted to the
+// signer by the sender with Approve.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the approved spender
+// @param from The address of the account to transfer tokens from
+// @param to The address of the recipient
+// @param amount The amount to transfer in the token's smallest unit
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (t *Token) TransferFrom(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	from, to radius.Address,
+	amount *big.Int,
+) (*radius.Receipt, error) {
+	return t.contract.Execute(ctx, client, signer, "transferFrom", from.EthAddress(), to.EthAddress(), amount)
+}
+
+// bigResult returns the single integer value decoded from a contract call result.
+//
+// @param result The decoded contract call result
+// @return The integer value and nil error on success
+// @return nil and error if the result is not a single integer value
+func bigResult(result []interface{}) (*big.Int, error) {
+	if len(result) != 1 {
+		return nil, fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	value, ok := result[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return value, nil
+}
+
+// stringResult returns the single string value decoded from a contract call result.
+//
+// @param result The decoded contract call result
+// @return The string value and nil error on success
+// @return Empty string and error if the result is not a single string value
+func stringResult(result []interface{}) (string, error) {
+	if len(result) != 1 {
+		return "", fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	value, ok := result[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return value, nil
+}
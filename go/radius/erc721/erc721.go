@@ -0,0 +1,313 @@
+// Package erc721 provides typed bindings for ERC-721 non-fungible token contracts on Radius, so callers can query
+// ownership, transfer tokens, and watch transfers without maintaining the ERC-721 ABI.
+package erc721
+
+import (
+	"context"
+	_ "embed" // Required to embed the ERC-721 ABI
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// abiJSON is the standard ERC-721 ABI, including the metadata extension
+//
+//go:embed ERC721.abi
+var abiJSON string
+
+// ABI returns the standard ERC-721 ABI.
+//
+// @return The parsed ERC-721 ABI
+func ABI() *radius.ABI {
+	return radius.ABIFromJSON(abiJSON)
+}
+
+// Transfer is a decoded ERC-721 Transfer event. Mints are transfers from the zero address, and burns are transfers
+// to the zero address.
+type Transfer struct {
+	// From is the address of the previous owner
+	From radius.Address
+
+	// To is the address of the new owner
+	To radius.Address
+
+	// TokenID is the ID of the transferred token
+	TokenID *big.Int
+}
+
+// WatchClient is an interface for watching contract events via a Radius Client.
+// This interface is implemented by the main Radius Client.
+type WatchClient interface {
+	radius.ContractClient
+
+	// WatchEventWithErrors watches for events with the given name emitted by the contract, and stops watching at the
+	// first error, which is sent to the returned error channel.
+	//
+	// @param ctx Context for the watch, which stops watching when done
+	// @param contract Contract instance that emits the events
+	// @param event Name of the event in the contract ABI
+	// @param interval Interval between polls for new logs when watching over HTTP
+	// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+	// @return Channel of decoded events, channel of the error that stopped watching, function to stop watching, and
+	// nil error on success
+	// @return nil, nil, nil, and error if the watch cannot start
+	WatchEventWithErrors(
+		ctx context.Context,
+		contract *radius.Contract,
+		event string,
+		interval time.Duration,
+		indexedFilters ...interface{},
+	) (<-chan radius.Event, <-chan error, func(), error)
+}
+
+// Token wraps a deployed ERC-721 contract with typed methods, so callers don't need to encode method names and
+// arguments or decode results by hand.
+type Token struct {
+	// contract is the underlying ERC-721 contract
+	contract *radius.Contract
+}
+
+// New creates a Token bound to the ERC-721 contract deployed at the given address.
+//
+// @param address The address of the deployed ERC-721 contract
+// @return A new Token instance
+func New(address radius.Address) *Token {
+	return &Token{contract: radius.NewContract(address, ABI())}
+}
+
+// Contract returns the underlying contract, which can be used to call methods that have no typed wrapper.
+//
+// @return The underlying ERC-721 contract
+func (t *Token) Contract() *radius.Contract {
+	return t.contract
+}
+
+// Approve allows the given address to transfer the token on behalf of the signer.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the token owner
+// @param to The address to approve, or the zero address to clear the approval
+// @param tokenID The ID of the token
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (t *Token) Approve(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	to radius.Address,
+	tokenID *big.Int,
+) (*radius.Receipt, error) {
+	return t.contract.Execute(ctx, client, signer, "approve", to.EthAddress(), tokenID)
+}
+
+// BalanceOf returns the number of tokens owned by the given address.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param owner The address of the owner to check
+// @return The number of tokens owned and nil error on success
+// @return nil and error if the contract call fails
+func (t *Token) BalanceOf(ctx context.Context, client radius.ContractClient, owner radius.Address) (*big.Int, error) {
+	result, err := t.contract.Call(ctx, client, "balanceOf", owner.EthAddress())
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 1 {
+		return nil, fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	balance, ok := result[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return balance, nil
+}
+
+// FilterTransfers returns the Transfer events emitted by the contract in the given block range.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to retrieve the events
+// @param fromBlock First block to search, or nil for the genesis block
+// @param toBlock Last block to search, or nil for the latest block
+// @return Decoded transfers in the order they were emitted and nil error on success
+// @return nil and error if the events cannot be retrieved or decoded
+func (t *Token) FilterTransfers(
+	ctx context.Context,
+	client radius.ContractClient,
+	fromBlock, toBlock *big.Int,
+) ([]Transfer, error) {
+	events, err := t.contract.FilterEvents(ctx, client, "Transfer", fromBlock, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]Transfer, len(events))
+	for i, event := range events {
+		if transfers[i], err = transferFromEvent(event); err != nil {
+			return nil, err
+		}
+	}
+
+	return transfers, nil
+}
+
+// OwnerOf returns the owner of the given token.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param tokenID The ID of the token
+// @return The address of the owner and nil error on success
+// @return Zero address and error if the contract call fails (e.g. the token does not exist)
+func (t *Token) OwnerOf(ctx context.Context, client radius.ContractClient, tokenID *big.Int) (radius.Address, error) {
+	result, err := t.contract.Call(ctx, client, "ownerOf", tokenID)
+	if err != nil {
+		return radius.ZeroAddress(), err
+	}
+	if len(result) != 1 {
+		return radius.ZeroAddress(), fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	return addressValue(result[0])
+}
+
+// SafeTransferFrom transfers the token from one address to another. If the recipient is a contract, it must implement
+// onERC721Received, or the transfer is reverted.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer of the token owner, or of an approved operator
+// @param from The address of the current owner
+// @param to The address of the recipient
+// @param tokenID The ID of the token
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction fails or is reverted
+func (t *Token) SafeTransferFrom(
+	ctx context.Context,
+	client radius.ContractClient,
+	signer radius.Signer,
+	from, to radius.Address,
+	tokenID *big.Int,
+) (*radius.Receipt, error) {
+	return t.contract.Execute(ctx, client, signer, "safeTransferFrom", from.EthAddress(), to.EthAddress(), tokenID)
+}
+
+// TokenURI returns the metadata URI of the given token. The URI is returned as is, so a data URI (e.g. with base64
+// encoded JSON metadata) is not decoded.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param tokenID The ID of the token
+// @return The token URI and nil error on success
+// @return Empty string and error if the contract call fails
+func (t *Token) TokenURI(ctx context.Context, client radius.ContractClient, tokenID *big.Int) (string, error) {
+	result, err := t.contract.Call(ctx, client, "tokenURI", tokenID)
+	if err != nil {
+		return "", err
+	}
+	if len(result) != 1 {
+		return "", fmt.Errorf("unexpected result length: %d", len(result))
+	}
+
+	uri, ok := result[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected result type: %T", result[0])
+	}
+
+	return uri, nil
+}
+
+// WatchTransfers watches for Transfer events emitted by the contract after the watch starts, and sends them to the
+// returned transfers channel. A log subscription is used if the client is connected over a websocket, and new blocks
+// are polled for logs at the given interval otherwise. Watching stops when the context is cancelled or an error
+// occurs, and the transfers channel is closed. Any error other than cancellation, including a failed subscription or
+// poll, or a log that cannot be decoded as a transfer, is sent to the returned error channel.
+//
+// @param ctx Context for the watcher, which stops watching when cancelled
+// @param client Radius client instance used to watch for the events
+// @param interval Interval between polls for new blocks
+// @return Channel of decoded transfers, and channel of the error that stopped watching
+func (t *Token) WatchTransfers(
+	ctx context.Context,
+	client WatchClient,
+	interval time.Duration,
+) (<-chan Transfer, <-chan error) {
+	transfers := make(chan Transfer)
+	errs := make(chan error, 1)
+
+	events, watchErrs, stop, err := client.WatchEventWithErrors(ctx, t.contract, "Transfer", interval)
+	if err != nil {
+		errs <- err
+		close(transfers)
+		return transfers, errs
+	}
+
+	go func() {
+		defer close(transfers)
+		defer stop()
+
+		for event := range events {
+			transfer, err := transferFromEvent(event)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case transfers <- transfer:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		// The events channel is closed after the error that stopped watching, if any, is sent
+		select {
+		case err := <-watchErrs:
+			errs <- err
+		default:
+		}
+	}()
+
+	return transfers, errs
+}
+
+// transferFromEvent converts a decoded Transfer event to a Transfer.
+//
+// @param event The decoded Transfer event
+// @return The Transfer and nil error on success
+// @return Empty Transfer and error if the event arguments have unexpected types
+func transferFromEvent(event radius.Event) (Transfer, error) {
+	from, err := addressValue(event.Data["from"])
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	to, err := addressValue(event.Data["to"])
+	if err != nil {
+		return Transfer{}, err
+	}
+
+	tokenID, ok := event.Data["tokenId"].(*big.Int)
+	if !ok {
+		return Transfer{}, fmt.Errorf("unexpected token ID type: %T", event.Data["tokenId"])
+	}
+
+	return Transfer{From: from, To: to, TokenID: tokenID}, nil
+}
+
+// addressValue converts a decoded ABI address value to a Radius Address.
+//
+// @param value The decoded address value
+// @return The Radius Address and nil error on success
+// @return Zero address and error if the value is not an address
+func addressValue(value interface{}) (radius.Address, error) {
+	address, ok := value.(interface{ Bytes() []byte })
+	if !ok || len(address.Bytes()) != 20 {
+		return radius.ZeroAddress(), fmt.Errorf("unexpected address type: %T", value)
+	}
+
+	return radius.NewAddress(address.Bytes()), nil
+}
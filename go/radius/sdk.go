@@ -2,7 +2,9 @@ package radius
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 	"net/http"
+	"time"
 
 	"github.com/radiustechsystems/sdk/go/src/accounts"
 	"github.com/radiustechsystems/sdk/go/src/auth"
@@ -11,31 +13,108 @@ import (
 	"github.com/radiustechsystems/sdk/go/src/client"
 	"github.com/radiustechsystems/sdk/go/src/common"
 	"github.com/radiustechsystems/sdk/go/src/contracts"
+	"github.com/radiustechsystems/sdk/go/src/crypto"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
 	"github.com/radiustechsystems/sdk/go/src/transport"
 )
 
-const MaxGas = common.MaxGas
+const (
+	BlockTagEarliest        = common.BlockTagEarliest
+	BlockTagFinalized       = common.BlockTagFinalized
+	BlockTagLatest          = common.BlockTagLatest
+	BlockTagPending         = common.BlockTagPending
+	BlockTagSafe            = common.BlockTagSafe
+	DeployStageBroadcasting = client.DeployStageBroadcasting
+	DeployStageEstimating   = client.DeployStageEstimating
+	DeployStageMined        = client.DeployStageMined
+	DeployStageSigning      = client.DeployStageSigning
+	DeployStageWaiting      = client.DeployStageWaiting
+	EtherDecimals           = common.EtherDecimals
+	EthereumMessagePrefix   = crypto.EthereumMessagePrefix
+	LogAll                  = transport.LogAll
+	LogErrorsOnly           = transport.LogErrorsOnly
+	MaxGas                  = common.MaxGas
+	SignerTypeEIP155        = privatekey.SignerTypeEIP155
+	SignerTypeHomestead     = privatekey.SignerTypeHomestead
+	SignerTypeLatest        = privatekey.SignerTypeLatest
+	SignerTypeLondon        = privatekey.SignerTypeLondon
+	StatusFailed            = common.StatusFailed
+	StatusSuccess           = common.StatusSuccess
+	TransferGas             = common.TransferGas
+	TxStateDropped          = client.TxStateDropped
+	TxStateMined            = client.TxStateMined
+	TxStatePending          = client.TxStatePending
+)
+
+// ErrMethodUnsupported is returned when the node does not support a JSON-RPC method.
+var ErrMethodUnsupported = client.ErrMethodUnsupported
+
+// ErrNotFound is returned when a requested item, such as a transaction receipt, is not known to the node yet.
+var ErrNotFound = client.ErrNotFound
 
 type (
-	ABI               = common.ABI
-	Account           = accounts.Account
-	AccountClient     = accounts.AccountClient
-	AccountOption     = accounts.Option
-	Address           = common.Address
-	AuthClient        = auth.SignerClient
-	ClefSigner        = clef.Signer
-	Client            = client.Client
-	ClientOption      = client.Option
-	Contract          = contracts.Contract
-	Event             = common.Event
-	Hash              = common.Hash
-	Interceptor       = transport.Interceptor
-	KeySigner         = privatekey.Signer
-	Logf              = transport.Logf
-	Receipt           = common.Receipt
-	Signer            = auth.Signer
-	SignedTransaction = common.SignedTransaction
-	Transaction       = common.Transaction
+	ABI                     = common.ABI
+	AccessList              = common.AccessList
+	AccessTuple             = common.AccessTuple
+	Account                 = accounts.Account
+	AccountClient           = accounts.AccountClient
+	AccountOption           = accounts.Option
+	AccountProof            = client.AccountProof
+	Address                 = common.Address
+	AddressSet              = common.AddressSet
+	AuthClient              = auth.SignerClient
+	Cache                   = client.Cache
+	CallMsg                 = client.CallMsg
+	ClefSigner              = clef.Signer
+	Client                  = client.Client
+	ClientOption            = client.Option
+	ClientVersion           = client.ClientVersion
+	Contract                = contracts.Contract
+	ContractClient          = contracts.ContractClient
+	Decoder                 = common.Decoder
+	Event                   = common.Event
+	ExecuteOptions          = contracts.ExecuteOptions
+	FeeHistory              = client.FeeHistory
+	GasObserver             = client.GasObserver
+	Hash                    = common.Hash
+	HealthStatus            = client.HealthStatus
+	Interceptor             = transport.Interceptor
+	KeySigner               = privatekey.Signer
+	KeySignerOption         = privatekey.Option
+	LargeMessageSigner      = auth.LargeMessageSigner
+	LogLevel                = transport.LogLevel
+	Logf                    = transport.Logf
+	MemoryCache             = client.MemoryCache
+	NetworkInfo             = client.NetworkInfo
+	NonceManager            = client.NonceManager
+	OverrideAccount         = client.OverrideAccount
+	PendingTransactionError = client.PendingTransactionError
+	Receipt                 = common.Receipt
+	ReceiptBatch            = common.ReceiptBatch
+	RequestIDFunc           = transport.RequestIDFunc
+	RequestInterceptor      = transport.RequestInterceptor
+	Resolver                = client.Resolver
+	RevertError             = client.RevertError
+	Signer                  = auth.Signer
+	SignedTransaction       = common.SignedTransaction
+	SignerType              = privatekey.SignerType
+	StateOverride           = client.StateOverride
+	StorageProof            = client.StorageProof
+	StorageSlot             = client.StorageSlot
+	StructLog               = client.StructLog
+	StructLogTrace          = client.StructLogTrace
+	SyncProgress            = client.SyncProgress
+	TraceOptions            = client.TraceOptions
+	Transaction             = common.Transaction
+	TransactionStatus       = common.TransactionStatus
+	TxCallback              = client.TxCallback
+	TxIndexer               = client.TxIndexer
+	TxOptions               = client.TxOptions
+	TxQueue                 = client.TxQueue
+	TxQueueOptions          = client.TxQueueOptions
+	TxResult                = client.TxResult
+	TxState                 = client.TxState
+	TxTracker               = client.TxTracker
 )
 
 // ABIFromJSON creates a new ABI with the given JSON string. If the JSON is invalid, it returns nil.
@@ -43,21 +122,129 @@ func ABIFromJSON(json string) *ABI {
 	return common.ABIFromJSON(json)
 }
 
+// AddressFromEth converts a go-ethereum common.Address to a Radius Address, which is a distinct type.
+func AddressFromEth(address eth.Address) Address {
+	return common.AddressFromEth(address)
+}
+
 // AddressFromHex creates an Address from a hex string. If the hex string is invalid, it returns an error.
 func AddressFromHex(h string) (Address, error) {
 	return common.AddressFromHex(h)
 }
 
+// AddressFromHexChecked creates an Address from a hex string like AddressFromHex, but returns an error if a mixed-case
+// hex string has an invalid EIP-55 checksum.
+func AddressFromHexChecked(h string) (Address, error) {
+	return common.AddressFromHexChecked(h)
+}
+
+// AddressFromPublicKey derives the Address of an ECDSA public key, e.g. a public key recovered from a signature.
+func AddressFromPublicKey(pub *ecdsa.PublicKey) Address {
+	return common.AddressFromPublicKey(pub)
+}
+
 // BytecodeFromHex converts a hex string to a byte slice. If the string is not a valid hex, it returns nil.
 func BytecodeFromHex(s string) []byte {
 	return common.BytecodeFromHex(s)
 }
 
+// CreateAddress returns the address of a contract deployed with CREATE by the given deployer with the given nonce.
+func CreateAddress(deployer Address, nonce uint64) Address {
+	return common.CreateAddress(deployer, nonce)
+}
+
+// CreateAddress2 returns the address of a contract deployed with CREATE2 by the given deployer contract.
+func CreateAddress2(deployer Address, salt [32]byte, initCode []byte) Address {
+	return common.CreateAddress2(deployer, salt, initCode)
+}
+
+// DecodeEvents decodes the events produced by the named event of the ABI into values of type T, skipping other events.
+func DecodeEvents[T any](events []Event, abi *ABI, eventName string) ([]T, error) {
+	return common.DecodeEvents[T](events, abi, eventName)
+}
+
+// DecodeSignedTransaction decodes serialized signed transaction bytes (e.g. submitted to a relayer) into a
+// SignedTransaction.
+func DecodeSignedTransaction(raw []byte) (*SignedTransaction, error) {
+	return common.DecodeSignedTransaction(raw)
+}
+
+// DedupeAddresses returns the addresses with duplicates removed, keeping the first occurrence of each address.
+func DedupeAddresses(addresses []Address) []Address {
+	return common.DedupeAddresses(addresses)
+}
+
+// FormatEther converts an amount in wei to a decimal ether amount (e.g. "1.5"), always with 18 decimals. Use
+// Client.FormatNative for native currencies set up with WithNativeDecimals.
+func FormatEther(wei *big.Int) string {
+	return common.FormatEther(wei)
+}
+
+// FormatUnits converts an integer amount of a unit with the given decimals to a decimal amount (e.g. "1.5").
+func FormatUnits(value *big.Int, decimals int) string {
+	return common.FormatUnits(value, decimals)
+}
+
+// FunctionSelector computes the 4-byte function selector of a function signature, e.g. "transfer(address,uint256)".
+func FunctionSelector(sig string) [4]byte {
+	return common.FunctionSelector(sig)
+}
+
+// GeneratePrivateKey generates a new random ECDSA private key.
+//
+// @return The generated private key and nil error on success
+// @return nil and error if key generation fails
+func GeneratePrivateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.GenerateKey()
+}
+
+// HashFromHex creates a Hash from a hex string. If the hex string is invalid, it returns an error.
+func HashFromHex(h string) (Hash, error) {
+	return common.HashFromHex(h)
+}
+
+// HashLargeMessage returns the hash signed for large messages by SignLargeMessage, which verifiers recover the signer
+// from.
+func HashLargeMessage(data []byte) []byte {
+	return crypto.HashLargeMessage(data)
+}
+
+// HashMessage returns the EIP-191 hash of a personal message, which is the hash signed by Signer.SignMessage.
+func HashMessage(msg []byte) []byte {
+	return crypto.HashMessage(msg)
+}
+
+// HashMessageWithPrefix returns the hash of a personal message with the given prefix, which is the hash signed by a
+// KeySigner created with WithMessagePrefix.
+func HashMessageWithPrefix(prefix string, msg []byte) []byte {
+	return crypto.HashMessageWithPrefix(prefix, msg)
+}
+
+// JoinSignature joins the R, S, and V components of a signature into the 65-byte [R || S || V] format.
+func JoinSignature(r, s [32]byte, v byte) []byte {
+	return crypto.JoinSignature(r, s, v)
+}
+
 // NewABI creates a new ABI with the given JSON string.
 func NewABI(abiJSON string) (*ABI, error) {
 	return common.NewABI(abiJSON)
 }
 
+// NewAccessListTransaction creates an EIP-2930 access list Transaction. If the chain ID is nil, the chain ID of the
+// signer is used.
+func NewAccessListTransaction(
+	chainID *big.Int,
+	nonce uint64,
+	to *Address,
+	value *big.Int,
+	gas uint64,
+	gasPrice *big.Int,
+	data []byte,
+	accessList AccessList,
+) *Transaction {
+	return common.NewAccessListTransaction(chainID, nonce, to, value, gas, gasPrice, data, accessList)
+}
+
 // NewAccount creates a new Radius Account with the given options.
 func NewAccount(opts ...AccountOption) *Account {
 	return accounts.New(opts...)
@@ -68,24 +255,172 @@ func NewAddress(b []byte) common.Address {
 	return common.NewAddress(b)
 }
 
+// NewAddressSet creates a new AddressSet containing the given addresses.
+func NewAddressSet(addresses ...Address) AddressSet {
+	return common.NewAddressSet(addresses...)
+}
+
 // NewClefSigner creates a new ClefSigner with the given Address, Radius Client, and Clef URL.
 func NewClefSigner(address common.Address, client AuthClient, clefURL string) (*ClefSigner, error) {
 	return clef.New(address, client, clefURL)
 }
 
+// NewClefSignerWithChainID creates a new ClefSigner with the given Address, Clef URL, and chain ID, without a Radius
+// Client. If the HTTP client is nil, the default HTTP client is used to connect to Clef.
+func NewClefSignerWithChainID(
+	address Address,
+	clefURL string,
+	chainID *big.Int,
+	httpClient *http.Client,
+) (*ClefSigner, error) {
+	return clef.NewWithChainID(address, clefURL, chainID, httpClient)
+}
+
 // NewClient creates a new Radius Client with the given URL and options.
 func NewClient(url string, opts ...ClientOption) (*Client, error) {
 	return client.New(url, opts...)
 }
 
-// NewContract creates a new Radius Contract with the given options.
+// NewClientWithEndpoints creates a new Radius Client that fails over between the given URLs of the same network.
+func NewClientWithEndpoints(urls []string, opts ...ClientOption) (*Client, error) {
+	return client.NewWithEndpoints(urls, opts...)
+}
+
+// NewContract creates a new Radius Contract with the given address and ABI, like Client.Bind.
 func NewContract(address Address, abi *ABI) *Contract {
 	return contracts.New(address, abi)
 }
 
-// NewKeySigner creates a new KeySigner with the given private key and Radius Client.
-func NewKeySigner(key *ecdsa.PrivateKey, client AuthClient) Signer {
-	return privatekey.New(key, client)
+// NewDecoder creates a new Decoder, which decodes events using the ABI registered for the address of each contract.
+func NewDecoder() *Decoder {
+	return common.NewDecoder()
+}
+
+// NewKeySigner creates a new KeySigner with the given private key, Radius Client, and options.
+func NewKeySigner(key *ecdsa.PrivateKey, client AuthClient, opts ...KeySignerOption) Signer {
+	return privatekey.New(key, client, opts...)
+}
+
+// NewKeySignerWithChainID creates a new KeySigner with the given private key and chain ID, which can sign
+// transactions without a Radius Client (e.g. offline with SignOffline).
+func NewKeySignerWithChainID(key *ecdsa.PrivateKey, chainID *big.Int, opts ...KeySignerOption) Signer {
+	return privatekey.NewWithChainID(key, chainID, opts...)
+}
+
+// NewMemoryCache creates a new MemoryCache, which caches immutable chain data in memory when passed to WithCache.
+func NewMemoryCache() *MemoryCache {
+	return client.NewMemoryCache()
+}
+
+// NewNonceManager creates a new NonceManager, which tracks sender nonces locally when passed to WithNonceManager.
+func NewNonceManager() *NonceManager {
+	return client.NewNonceManager()
+}
+
+// NewTransaction creates a new legacy Transaction. A nil value or gas price defaults to zero.
+func NewTransaction(nonce uint64, to *Address, value *big.Int, gas uint64, gasPrice *big.Int, data []byte) *Transaction {
+	return common.NewTransaction(nonce, to, value, gas, gasPrice, data)
+}
+
+// NewTxQueue creates a new TxQueue, which sends the transactions of a signer in nonce order while limiting the number
+// of transactions in flight and the rate at which they are sent.
+func NewTxQueue(radiusClient *Client, signer Signer, opts TxQueueOptions) *TxQueue {
+	return client.NewTxQueue(radiusClient, signer, opts)
+}
+
+// NewTxTracker creates a new TxTracker, which sends transactions without waiting for them to be mined and calls the
+// given callback once Reconcile finds their receipts.
+func NewTxTracker(radiusClient *Client, callback TxCallback) *TxTracker {
+	return client.NewTxTracker(radiusClient, callback)
+}
+
+// ParseEther converts a decimal ether amount (e.g. "1.5") to wei.
+func ParseEther(amount string) (*big.Int, error) {
+	return common.ParseEther(amount)
+}
+
+// ParseUnits converts a decimal amount (e.g. "1.5") to an integer amount of a unit with the given decimals.
+func ParseUnits(amount string, decimals int) (*big.Int, error) {
+	return common.ParseUnits(amount, decimals)
+}
+
+// PublicKeyBytes returns the 33-byte compressed or 65-byte uncompressed serialized form of an ECDSA public key.
+func PublicKeyBytes(pub *ecdsa.PublicKey, compressed bool) []byte {
+	return crypto.PublicKeyBytes(pub, compressed)
+}
+
+// RecoverPublicKey recovers the ECDSA public key that created the given 65-byte signature of a digest hash.
+func RecoverPublicKey(digest, sig []byte) (*ecdsa.PublicKey, error) {
+	return crypto.RecoverPublicKey(digest, sig)
+}
+
+// SignLargeMessage signs data of any length by signing its Keccak256 hash with a domain prefix (see HashLargeMessage).
+// The signer must implement LargeMessageSigner.
+func SignLargeMessage(signer Signer, data []byte) ([]byte, error) {
+	return auth.SignLargeMessage(signer, data)
+}
+
+// SignOffline signs a fully specified Transaction without network access, for broadcast later with
+// SendRawTransaction.
+func SignOffline(tx *Transaction, signer Signer) (*SignedTransaction, error) {
+	return auth.SignOffline(tx, signer)
+}
+
+// SortAddresses sorts the addresses in place in ascending byte order.
+func SortAddresses(addresses []Address) {
+	common.SortAddresses(addresses)
+}
+
+// SplitSignature splits a 65-byte [R || S || V] signature into its R, S, and V components.
+func SplitSignature(sig []byte) (r [32]byte, s [32]byte, v byte, err error) {
+	return crypto.SplitSignature(sig)
+}
+
+// WithAutoReplace returns a ClientOption that re-signs and resends underpriced replacement transactions with the
+// gas price bumped by the given percentage.
+func WithAutoReplace(bumpPercent int) ClientOption {
+	return client.WithAutoReplace(bumpPercent)
+}
+
+// WithBalanceCheck returns an AccountOption that checks that an Account can afford the amount of a transfer before
+// sending it.
+func WithBalanceCheck() AccountOption {
+	return accounts.WithBalanceCheck()
+}
+
+// WithCache returns a ClientOption that caches the chain ID and deployed contract code with the given Cache.
+func WithCache(cache Cache) ClientOption {
+	return client.WithCache(cache)
+}
+
+// WithClient returns an AccountOption that binds a client to an Account, which is used by the Account methods without
+// a client argument.
+func WithClient(client AccountClient) AccountOption {
+	return accounts.WithClient(client)
+}
+
+// WithDeployValidation returns a ClientOption that logs a warning when the code of a deployed contract does not
+// appear to match its ABI.
+func WithDeployValidation() ClientOption {
+	return client.WithDeployValidation()
+}
+
+// WithGasMargin returns a ClientOption that sets the safety margin added to gas estimates, as a percentage of the
+// estimate.
+func WithGasMargin(percent int) ClientOption {
+	return client.WithGasMargin(percent)
+}
+
+// WithGasObserver returns a ClientOption that calls the observer with the gas limit and gas used of each transaction
+// once it is mined, including transactions that failed.
+func WithGasObserver(observer GasObserver) ClientOption {
+	return client.WithGasObserver(observer)
+}
+
+// WithGasReserve returns an AccountOption that sets the amount reserved for gas fees when checking whether an Account
+// can afford a transaction.
+func WithGasReserve(reserve *big.Int) AccountOption {
+	return accounts.WithGasReserve(reserve)
 }
 
 // WithHTTPClient returns a ContractOption that sets the Radius chain ID for the contract.
@@ -98,11 +433,52 @@ func WithInterceptor(interceptor Interceptor) ClientOption {
 	return client.WithInterceptor(interceptor)
 }
 
+// WithLogLevel returns a ClientOption that controls which requests and responses are logged, e.g. LogErrorsOnly to log
+// only failed requests in production.
+func WithLogLevel(level LogLevel) ClientOption {
+	return client.WithLogLevel(level)
+}
+
 // WithLogger returns a ClientOption that adds request/response logging to a Radius Client.
 func WithLogger(logger Logf) ClientOption {
 	return client.WithLogger(logger)
 }
 
+// WithMaxBodyLog returns a ClientOption that limits how many bytes of each response body are logged, streaming the
+// rest of the body without buffering it.
+func WithMaxBodyLog(maxBytes int) ClientOption {
+	return client.WithMaxBodyLog(maxBytes)
+}
+
+// WithMaxGas returns a ClientOption that caps the gas limit of estimated transactions.
+func WithMaxGas(maxGas uint64) ClientOption {
+	return client.WithMaxGas(maxGas)
+}
+
+// WithMessagePrefix returns a KeySignerOption that sets the prefix of personal messages signed by a KeySigner, for
+// chains that don't use the Ethereum prefix.
+func WithMessagePrefix(prefix string) KeySignerOption {
+	return privatekey.WithMessagePrefix(prefix)
+}
+
+// WithNativeDecimals returns a ClientOption that sets the number of decimals of the native currency used to format
+// amounts, which is 18 by default and must not be negative.
+func WithNativeDecimals(decimals int) ClientOption {
+	return client.WithNativeDecimals(decimals)
+}
+
+// WithNonceManager returns a ClientOption that tracks sender nonces locally with the given NonceManager, resyncing
+// from the node when a transaction is rejected with "nonce too low".
+func WithNonceManager(manager *NonceManager) ClientOption {
+	return client.WithNonceManager(manager)
+}
+
+// WithPollInterval returns a ClientOption that sets the interval between polls for new logs when watching events over
+// HTTP.
+func WithPollInterval(interval time.Duration) ClientOption {
+	return client.WithPollInterval(interval)
+}
+
 // WithPrivateKey returns an AccountOption that adds a KeySigner and Address to an Account using a private key.
 func WithPrivateKey(key *ecdsa.PrivateKey, client AccountClient) AccountOption {
 	return accounts.WithPrivateKey(key, client)
@@ -114,12 +490,56 @@ func WithPrivateKeyHex(key string, client AccountClient) AccountOption {
 	return accounts.WithPrivateKeyHex(key, client)
 }
 
+// WithRequestIDFunc returns a ClientOption that generates the IDs of outgoing JSON-RPC requests with the given
+// RequestIDFunc.
+func WithRequestIDFunc(generate RequestIDFunc) ClientOption {
+	return client.WithRequestIDFunc(generate)
+}
+
+// WithRequestInterceptor returns a ClientOption that adds an outgoing request RequestInterceptor to a Radius Client.
+func WithRequestInterceptor(interceptor RequestInterceptor) ClientOption {
+	return client.WithRequestInterceptor(interceptor)
+}
+
+// WithResolver returns a ClientOption that sets the name Resolver used by a Radius Client.
+func WithResolver(resolver Resolver) ClientOption {
+	return client.WithResolver(resolver)
+}
+
 // WithSigner returns an AccountOption that adds a Signer to an Account. The Signer is used to derive the Address of
 // the Account and sign transactions. The Signer must implement the Signer interface (e.g. ClefSigner, KeySigner).
 func WithSigner(signer Signer) AccountOption {
 	return accounts.WithSigner(signer)
 }
 
+// WithSignerType returns a KeySignerOption that sets the transaction signing rules used by a KeySigner.
+func WithSignerType(signerType SignerType) KeySignerOption {
+	return privatekey.WithSignerType(signerType)
+}
+
+// WithTransferGasEstimation returns a ClientOption that estimates the gas of plain value transfers, instead of using
+// TransferGas.
+func WithTransferGasEstimation() ClientOption {
+	return client.WithTransferGasEstimation()
+}
+
+// WithTransport returns a ClientOption that sets the HTTP transport used to send requests, e.g. to tune connection
+// pooling and timeouts.
+func WithTransport(transport *http.Transport) ClientOption {
+	return client.WithTransport(transport)
+}
+
+// WithTxIndexer returns a ClientOption that looks up the transactions of an account with the given TxIndexer instead
+// of scanning blocks.
+func WithTxIndexer(indexer TxIndexer) ClientOption {
+	return client.WithTxIndexer(indexer)
+}
+
+// WithUserAgent returns a ClientOption that sends the given User-Agent header with each request.
+func WithUserAgent(userAgent string) ClientOption {
+	return client.WithUserAgent(userAgent)
+}
+
 // ZeroAddress returns the zero address.
 func ZeroAddress() Address {
 	return common.ZeroAddress()
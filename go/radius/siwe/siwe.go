@@ -0,0 +1,44 @@
+// Package siwe provides Sign-In with Ethereum (EIP-4361) support for Radius accounts, so services can authenticate
+// users by the address of their wallet. Messages are signed with Account.SignSIWE, and verified with Verify.
+package siwe
+
+import (
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/src/siwe"
+)
+
+// Version is the only SIWE message version defined by EIP-4361
+const Version = siwe.Version
+
+// Params contains the fields of a SIWE message. Optional fields are omitted from the message when empty.
+type Params = siwe.Params
+
+// BuildMessage builds the canonical SIWE message for the given parameters, which is signed with EIP-191.
+//
+// @param params The fields of the message
+// @return The SIWE message and nil error on success
+// @return Empty string and error if a field is missing or invalid
+func BuildMessage(params Params) (string, error) {
+	return siwe.BuildMessage(params)
+}
+
+// ParseMessage parses a SIWE message into its fields, e.g. to check the domain and nonce of a verified message.
+//
+// @param message The SIWE message
+// @return The fields of the message and nil error on success
+// @return Empty Params and error if the message is malformed, or a field is missing or invalid
+func ParseMessage(message string) (Params, error) {
+	return siwe.ParseMessage(message)
+}
+
+// Verify verifies the signature of a SIWE message, and returns the address of the account that signed in. The
+// message must be valid at the current time, and the service must check that its domain and nonce are the expected
+// ones (see ParseMessage).
+//
+// @param message The signed SIWE message
+// @param signature The 65-byte signature of the message
+// @return The address of the account that signed the message and nil error on success
+// @return Zero address and error if the message is invalid, expired, or not signed by the message address
+func Verify(message string, signature []byte) (radius.Address, error) {
+	return siwe.Verify(message, signature)
+}
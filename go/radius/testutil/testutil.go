@@ -0,0 +1,80 @@
+// Package testutil provides helpers for tests and examples that use the Radius SDK, such as signers with reproducible
+// keys and funded accounts. The keys created by these helpers are not secret, so they must never be used to hold real funds.
+package testutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/src/crypto"
+)
+
+// CreateFundedAccount creates an Account with a new random private key, bound to the given client, and funds it with
+// the given amount from the funder, waiting for the funding transaction to be mined. The private key is returned too,
+// so the funds can be recovered, or the key used with other tools.
+//
+// @param ctx Context for the requests
+// @param client Radius client used to fund the account, which is bound to the Account
+// @param funder The signer of the account that sends the funds
+// @param amount Amount to fund the account with in wei
+// @return The funded Account, its private key, and nil error on success
+// @return nil, nil, and error if the key cannot be generated, the chain ID cannot be retrieved, or the funding fails
+func CreateFundedAccount(
+	ctx context.Context,
+	client *radius.Client,
+	funder radius.Signer,
+	amount *big.Int,
+) (*radius.Account, *ecdsa.PrivateKey, error) {
+	key, err := radius.GeneratePrivateKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account := radius.NewAccount(
+		radius.WithSigner(radius.NewKeySignerWithChainID(key, chainID)),
+		radius.WithClient(client),
+	)
+	if _, err = client.Send(ctx, funder, account.Address(), amount); err != nil {
+		return nil, nil, fmt.Errorf("failed to fund account: %w", err)
+	}
+
+	return account, key, nil
+}
+
+// DeterministicKey derives an ECDSA private key on the secp256k1 curve from the given seed, so the same seed always
+// yields the same key. The key is the Keccak256 hash of the seed, which is hashed again in the unlikely case that it is
+// not a valid key. The key is only as secret as the seed, so this must only be used in tests, e.g. for golden-file tests
+// of signatures and addresses, and never to hold real funds.
+//
+// @param seed The seed to derive the key from
+// @return The derived private key
+func DeterministicKey(seed string) *ecdsa.PrivateKey {
+	hash := crypto.Keccak256([]byte(seed))
+	for {
+		if key, err := crypto.HexToECDSA(hex.EncodeToString(hash)); err == nil {
+			return key
+		}
+		hash = crypto.Keccak256(hash)
+	}
+}
+
+// NewDeterministicSigner creates a new KeySigner with a private key derived from the given seed (see DeterministicKey)
+// and the given chain ID, so the same seed always yields the same address and signatures. The key is only as secret as
+// the seed, so this must only be used in tests, e.g. for golden-file tests of signatures and addresses.
+//
+// @param seed The seed to derive the key from
+// @param chainID The chain ID used to sign transactions
+// @param opts Options for the KeySigner
+// @return The KeySigner
+func NewDeterministicSigner(seed string, chainID *big.Int, opts ...radius.KeySignerOption) radius.Signer {
+	return radius.NewKeySignerWithChainID(DeterministicKey(seed), chainID, opts...)
+}
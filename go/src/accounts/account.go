@@ -5,19 +5,34 @@ package accounts
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/contracts"
+	"github.com/radiustechsystems/sdk/go/src/siwe"
 )
 
+// errClientRequired is returned by the With methods of an Account when they are called with a nil client
+var errClientRequired = errors.New("client is required")
+
 // Account represents a Radius account that can be used to sign transactions.
 // This struct provides methods for checking balance, retrieving nonce, and
 // signing messages and transactions.
 type Account struct {
 	// Signer used to cryptographically sign messages and transactions
 	Signer auth.Signer
+
+	// client is the client used by methods without a client argument, if set
+	client AccountClient
+
+	// balanceCheck enables checking that the account can afford the value of a transaction before sending it
+	balanceCheck bool
+
+	// gasReserve is the amount in wei reserved for gas fees when checking whether the account can afford a transaction
+	gasReserve *big.Int
 }
 
 // New creates a new Account with the given Option(s).
@@ -42,42 +57,316 @@ func (a *Account) Address() common.Address {
 	return common.ZeroAddress()
 }
 
-// Balance returns the balance of the account in wei.
+// Balance returns the balance of the account in wei, using the client set with WithClient.
+//
+// @param ctx Context for the request
+// @return The account balance in wei and nil error on success
+// @return nil and error if no client is set with WithClient
+// @return nil and error if the balance cannot be retrieved from the network
+func (a *Account) Balance(ctx context.Context) (*big.Int, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.BalanceWith(ctx, client)
+}
+
+// BalanceEther returns the balance of the account as a decimal ether amount (e.g. "1.5"), for display, using the
+// client set with WithClient. See BalanceEtherWith for how the balance is formatted.
+//
+// @param ctx Context for the request
+// @return The account balance as a decimal ether amount and nil error on success
+// @return Empty string and error if no client is set with WithClient
+// @return Empty string and error if the balance cannot be retrieved from the network
+func (a *Account) BalanceEther(ctx context.Context) (string, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return "", err
+	}
+
+	return a.BalanceEtherWith(ctx, client)
+}
+
+// BalanceEtherWith returns the balance of the account as a decimal ether amount (e.g. "1.5"), for display. The balance
+// is formatted with the native decimals of the client if it has them (see the client's WithNativeDecimals option), or
+// 18 decimals otherwise.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to query the balance
+// @return The account balance as a decimal ether amount and nil error on success
+// @return Empty string and error if the client is nil
+// @return Empty string and error if the balance cannot be retrieved from the network
+func (a *Account) BalanceEtherWith(ctx context.Context, client AccountClient) (string, error) {
+	balance, err := a.BalanceWith(ctx, client)
+	if err != nil {
+		return "", err
+	}
+
+	if native, ok := client.(nativeDecimalsClient); ok {
+		return common.FormatUnits(balance, native.NativeDecimals()), nil
+	}
+	return common.FormatEther(balance), nil
+}
+
+// BalanceWith returns the balance of the account in wei.
 //
 // @param ctx Context for the request
 // @param client Radius client instance used to query the balance
 // @return The account balance in wei and nil error on success
+// @return nil and error if the client is nil
 // @return nil and error if the balance cannot be retrieved from the network
-func (a *Account) Balance(ctx context.Context, client AccountClient) (*big.Int, error) {
+func (a *Account) BalanceWith(ctx context.Context, client AccountClient) (*big.Int, error) {
+	if client == nil {
+		return nil, errClientRequired
+	}
+
 	return client.BalanceAt(ctx, a.Address())
 }
 
-// Nonce returns the next nonce (transaction count) of the account.
+// CanAfford returns whether the balance of the account covers the given value plus the gas reserve, using the client
+// set with WithClient. See CanAffordWith for details.
+//
+// @param ctx Context for the request
+// @param value Amount of native currency to send in wei
+// @return Whether the account can afford the value, the shortfall in wei (zero if affordable), and nil error on success
+// @return false, nil, and error if no client is set with WithClient
+// @return false, nil, and error if the balance cannot be retrieved from the network
+func (a *Account) CanAfford(ctx context.Context, value *big.Int) (bool, *big.Int, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return false, nil, err
+	}
+
+	return a.CanAffordWith(ctx, client, value)
+}
+
+// CanAffordWith returns whether the balance of the account covers the given value plus the gas reserve set with the
+// WithGasReserve option. Radius transactions may have no gas fees, so the gas reserve is zero by default.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to query the balance
+// @param value Amount of native currency to send in wei
+// @return Whether the account can afford the value, the shortfall in wei (zero if affordable), and nil error on success
+// @return false, nil, and error if the client is nil
+// @return false, nil, and error if the balance cannot be retrieved from the network
+func (a *Account) CanAffordWith(ctx context.Context, client AccountClient, value *big.Int) (bool, *big.Int, error) {
+	balance, err := a.BalanceWith(ctx, client)
+	if err != nil {
+		return false, nil, err
+	}
+
+	required := new(big.Int)
+	if value != nil {
+		required.Set(value)
+	}
+	if a.gasReserve != nil {
+		required.Add(required, a.gasReserve)
+	}
+
+	shortfall := new(big.Int).Sub(required, balance)
+	if shortfall.Sign() <= 0 {
+		return true, big.NewInt(0), nil
+	}
+
+	return false, shortfall, nil
+}
+
+// Execute executes a state-changing contract method, signing the transaction with the account's signer, using the
+// client set with WithClient. The bound client must also implement contracts.ContractClient, as the Radius Client
+// does.
+//
+// @param ctx Context for the request
+// @param contract Contract instance to interact with
+// @param method Name of the method to execute on the contract
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if no signer is available
+// @return nil and error if no client is set with WithClient, or it cannot execute contract methods
+// @return nil and error if the transaction fails or is reverted
+func (a *Account) Execute(
+	ctx context.Context,
+	contract *contracts.Contract,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	bound, err := a.boundClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client, ok := bound.(contracts.ContractClient)
+	if !ok {
+		return nil, fmt.Errorf("contract client is required for executing contract methods")
+	}
+
+	return a.ExecuteWith(ctx, client, contract, method, args...)
+}
+
+// ExecuteWith executes a state-changing contract method, signing the transaction with the account's signer. This
+// mirrors SendWith for contract calls, and is equivalent to calling Contract.Execute with the account's signer.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param contract Contract instance to interact with
+// @param method Name of the method to execute on the contract
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if no signer is available
+// @return nil and error if the client is nil
+// @return nil and error if the transaction fails or is reverted
+func (a *Account) ExecuteWith(
+	ctx context.Context,
+	client contracts.ContractClient,
+	contract *contracts.Contract,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	if a.Signer == nil {
+		return nil, fmt.Errorf("signer is required for sending transactions")
+	}
+	if client == nil {
+		return nil, errClientRequired
+	}
+
+	return contract.Execute(ctx, client, a.Signer, method, args...)
+}
+
+// Nonce returns the next nonce (transaction count) of the account, using the client set with WithClient.
+//
+// @param ctx Context for the request
+// @return The next nonce to use for transactions and nil error on success
+// @return 0 and error if no client is set with WithClient
+// @return 0 and error if the nonce cannot be retrieved from the network
+func (a *Account) Nonce(ctx context.Context) (uint64, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return 0, err
+	}
+
+	return a.NonceWith(ctx, client)
+}
+
+// NonceAt returns the nonce of the account at the given block tag, using the client set with WithClient. See
+// NonceAtWith for details.
+//
+// @param ctx Context for the request
+// @param blockTag Block tag to query the nonce at (e.g. "latest" or "pending")
+// @return The nonce at the given block tag and nil error on success
+// @return 0 and error if no client is set with WithClient
+// @return 0 and error if the nonce cannot be retrieved from the network
+func (a *Account) NonceAt(ctx context.Context, blockTag string) (uint64, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return 0, err
+	}
+
+	return a.NonceAtWith(ctx, client, blockTag)
+}
+
+// NonceAtWith returns the nonce of the account at the given block tag. Use common.BlockTagLatest to get the nonce of
+// confirmed transactions only, which is useful when reconciling after pending transactions may have been dropped.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to query the nonce
+// @param blockTag Block tag to query the nonce at (e.g. "latest" or "pending")
+// @return The nonce at the given block tag and nil error on success
+// @return 0 and error if the client is nil
+// @return 0 and error if the nonce cannot be retrieved from the network
+func (a *Account) NonceAtWith(ctx context.Context, client AccountClient, blockTag string) (uint64, error) {
+	if client == nil {
+		return 0, errClientRequired
+	}
+
+	return client.NonceAtTag(ctx, a.Address(), blockTag)
+}
+
+// NonceWith returns the next nonce (transaction count) of the account.
 //
 // @param ctx Context for the request
 // @param client Radius client instance used to query the nonce
 // @return The next nonce to use for transactions and nil error on success
+// @return 0 and error if the client is nil
 // @return 0 and error if the nonce cannot be retrieved from the network
-func (a *Account) Nonce(ctx context.Context, client AccountClient) (uint64, error) {
+func (a *Account) NonceWith(ctx context.Context, client AccountClient) (uint64, error) {
+	if client == nil {
+		return 0, errClientRequired
+	}
+
 	return client.PendingNonceAt(ctx, a.Address())
 }
 
-// Send sends native currency to a recipient address.
+// Send sends native currency to a recipient address, using the client set with WithClient.
+//
+// @param ctx Context for the request
+// @param recipient Destination address to receive the funds
+// @param amount Amount of native currency to send in wei
+// @return Receipt of the completed transaction and nil error on success
+// @return nil and error if no signer is available, or no client is set with WithClient
+// @return nil and error if balance checks are enabled with WithBalanceCheck, and the account cannot afford the amount
+// @return nil and error if the transaction fails
+func (a *Account) Send(ctx context.Context, recipient common.Address, amount *big.Int) (*common.Receipt, error) {
+	client, err := a.boundClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.SendWith(ctx, client, recipient, amount)
+}
+
+// SendWith sends native currency to a recipient address.
 //
 // @param ctx Context for the request
 // @param client Radius client instance used to send the transaction
 // @param recipient Destination address to receive the funds
 // @param amount Amount of native currency to send in wei
 // @return Receipt of the completed transaction and nil error on success
-// @return nil and error if no signer is available
+// @return nil and error if no signer is available, or the client is nil
+// @return nil and error if balance checks are enabled with WithBalanceCheck, and the account cannot afford the amount
 // @return nil and error if the transaction fails
-func (a *Account) Send(ctx context.Context, client AccountClient, recipient common.Address, amount *big.Int) (*common.Receipt, error) {
+func (a *Account) SendWith(
+	ctx context.Context,
+	client AccountClient,
+	recipient common.Address,
+	amount *big.Int,
+) (*common.Receipt, error) {
 	if a.Signer == nil {
 		return nil, fmt.Errorf("signer is required for sending transactions")
 	}
+	if client == nil {
+		return nil, errClientRequired
+	}
+
+	if a.balanceCheck {
+		ok, shortfall, err := a.CanAffordWith(ctx, client, amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check balance: %w", err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("insufficient balance: short by %s wei", shortfall)
+		}
+	}
+
 	return client.Send(ctx, a.Signer, recipient, amount)
 }
 
+// SignAndSerialize signs a fully specified transaction without any network access, and returns the serialized signed
+// transaction. This is the account counterpart of auth.SignOffline for cold-wallet workflows: the transaction can be
+// signed on an air-gapped machine, and the bytes broadcast from another machine with the Client method
+// SendRawTransaction. The nonce, gas limit, and gas price or fee cap must be set by the caller.
+//
+// @param tx The fully specified transaction to sign
+// @return The serialized signed transaction and nil error on success
+// @return nil and error if no signer is available
+// @return nil and error if the transaction is incomplete or cannot be signed
+func (a *Account) SignAndSerialize(tx *common.Transaction) ([]byte, error) {
+	signed, err := auth.SignOffline(tx, a.Signer)
+	if err != nil {
+		return nil, err
+	}
+	return signed.Serialized, nil
+}
+
 // SignMessage signs a message using the EIP-191 standard.
 //
 // @param msg Message bytes to sign
@@ -97,6 +386,39 @@ func (a *Account) SignMessage(msg []byte) ([]byte, error) {
 	return signature, nil
 }
 
+// SignSIWE builds a Sign-In with Ethereum (EIP-4361) message with the given parameters, and signs it with EIP-191. If
+// the address of the parameters is not set, the address of the account is used.
+//
+// @param params The fields of the SIWE message
+// @return The SIWE message, its signature, and nil error on success
+// @return Empty message, nil, and error if no signer is available
+// @return Empty message, nil, and error if the address belongs to another account, or a field is missing or invalid
+// @return Empty message, nil, and error if signing fails
+func (a *Account) SignSIWE(params siwe.Params) (message string, signature []byte, err error) {
+	if a.Signer == nil {
+		return "", nil, fmt.Errorf("signer is required for signing messages")
+	}
+
+	address := a.Address()
+	if params.Address.Equals(common.ZeroAddress()) {
+		params.Address = address
+	} else if !params.Address.Equals(address) {
+		return "", nil, fmt.Errorf("SIWE address %s does not match the account address %s", params.Address.Hex(), address.Hex())
+	}
+
+	message, err = siwe.BuildMessage(params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	signature, err = a.SignMessage([]byte(message))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return message, signature, nil
+}
+
 // SignTransaction signs a transaction using the EIP-155 standard.
 //
 // @param tx Transaction to sign
@@ -115,3 +437,11 @@ func (a *Account) SignTransaction(tx *common.Transaction) (*common.SignedTransac
 
 	return signedTx, nil
 }
+
+// boundClient returns the client set with WithClient.
+func (a *Account) boundClient() (AccountClient, error) {
+	if a.client == nil {
+		return nil, fmt.Errorf("client is required, bind one with WithClient or use the With variant of the method")
+	}
+	return a.client, nil
+}
@@ -5,6 +5,7 @@ package accounts
 
 import (
 	"crypto/ecdsa"
+	"math/big"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/auth/privatekey"
@@ -15,6 +16,40 @@ import (
 // Options allow for a flexible API to construct accounts with various configurations.
 type Option func(*Account)
 
+// WithBalanceCheck enables checking that the Account can afford the amount of a transfer before sending it with
+// Account.Send, so transfers that would fail for lack of funds are not broadcast.
+//
+// @return An Option function that enables balance checks on an Account
+func WithBalanceCheck() Option {
+	return func(a *Account) {
+		a.balanceCheck = true
+	}
+}
+
+// WithClient binds a client to the Account, which is used by the Account methods without a client argument (e.g.
+// Balance and Send), so the client doesn't have to be passed to every call. The With variants of the methods (e.g.
+// BalanceWith and SendWith) use the client passed to them instead. Execute can only use the bound client if it also
+// implements contracts.ContractClient, as the Radius Client does.
+//
+// @param client AccountClient used by the Account methods without a client argument
+// @return An Option function that binds the client to an Account
+func WithClient(client AccountClient) Option {
+	return func(a *Account) {
+		a.client = client
+	}
+}
+
+// WithGasReserve sets the amount reserved for gas fees when checking whether the Account can afford a transaction
+// with Account.CanAfford. Radius transactions may have no gas fees, so no amount is reserved by default.
+//
+// @param reserve Amount to reserve for gas fees in wei
+// @return An Option function that sets the gas reserve of an Account
+func WithGasReserve(reserve *big.Int) Option {
+	return func(a *Account) {
+		a.gasReserve = reserve
+	}
+}
+
 // WithPrivateKey creates an Account using a private key.
 //
 // @param key ECDSA private key to use for signing
@@ -43,6 +43,15 @@ type AccountClient interface {
 	// @return The HTTP client used for API requests
 	HTTPClient() *http.Client
 
+	// NonceAtTag returns the nonce of an account at the given block tag.
+	//
+	// @param ctx Context for the request
+	// @param address Address to check the nonce for
+	// @param blockTag Block tag to query the nonce at (e.g. "latest" or "pending")
+	// @return The nonce at the given block tag and nil error on success
+	// @return 0 and error if the nonce cannot be retrieved from the network
+	NonceAtTag(ctx context.Context, address common.Address, blockTag string) (uint64, error)
+
 	// PendingNonceAt returns the next nonce (transaction count) for an account.
 	//
 	// @param ctx Context for the request
@@ -62,3 +71,12 @@ type AccountClient interface {
 	// @return nil and error if the transaction receipt is not returned
 	Send(ctx context.Context, signer auth.Signer, recipient common.Address, amount *big.Int) (*common.Receipt, error)
 }
+
+// nativeDecimalsClient is implemented by clients that know the number of decimals of the native currency, such as the
+// main Radius Client with the WithNativeDecimals option. It is optional, and 18 decimals are assumed otherwise.
+type nativeDecimalsClient interface {
+	// NativeDecimals returns the number of decimals of the native currency.
+	//
+	// @return The number of decimals of the native currency
+	NativeDecimals() int
+}
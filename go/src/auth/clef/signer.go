@@ -5,16 +5,28 @@ package clef
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/crypto"
 	"github.com/radiustechsystems/sdk/go/src/providers/eth"
 )
 
+// publicKeyMessage is the start of the message signed to recover the public key of the account, which is followed by
+// a random nonce so the signature cannot be replayed as a proof of control of the account
+const publicKeyMessage = "Radius SDK public key request"
+
+// publicKeyNonceSize is the number of random bytes in the nonce of the public key request message
+const publicKeyNonceSize = 16
+
 // Signer implements the Signer interface using the Clef JSON-RPC API.
 // Clef is a secure key management service that can be used to sign transactions without exposing
 // the private key to the application. This is useful for securing private keys in production systems.
@@ -30,6 +42,12 @@ type Signer struct {
 	// client is the RPC client used to communicate with the Clef server
 	client *eth.RPCClient
 
+	// publicKey is the cached public key of the account, which is set by the first successful call to PublicKey
+	publicKey *ecdsa.PublicKey
+
+	// publicKeyMu guards publicKey
+	publicKeyMu sync.Mutex
+
 	// signer is the underlying Ethereum signer implementation
 	signer eth.Signer
 }
@@ -40,7 +58,27 @@ type Signer struct {
 // @param clefURL The URL of the Clef server (e.g. "http://localhost:8550")
 // @return A new Signer instance, or an error if the connection fails
 func New(address common.Address, client auth.SignerClient, clefURL string) (*Signer, error) {
-	clefClient, err := eth.NewRPCClient(clefURL, client.HTTPClient())
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		chainID = new(big.Int)
+	}
+
+	return NewWithChainID(address, clefURL, chainID, client.HTTPClient())
+}
+
+// NewWithChainID creates a new Signer with the given address, Clef server URL, and a known chain ID. Unlike New, no
+// Radius Client is required, so the Signer can be created without access to a Radius node.
+// @param address The address to use for signing
+// @param clefURL The URL of the Clef server (e.g. "http://localhost:8550")
+// @param chainID The chain ID of the network the transactions are signed for
+// @param httpClient The HTTP client used to connect to Clef, or nil for the default HTTP client
+// @return A new Signer instance, or an error if the connection fails
+func NewWithChainID(address common.Address, clefURL string, chainID *big.Int, httpClient *http.Client) (*Signer, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	clefClient, err := eth.NewRPCClient(clefURL, httpClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Clef: %w", err)
 	}
@@ -51,16 +89,11 @@ func New(address common.Address, client auth.SignerClient, clefURL string) (*Sig
 		return nil, fmt.Errorf("failed to verify Clef connection: %w", err)
 	}
 
-	chainID, err := client.ChainID(context.Background())
-	if err != nil {
-		chainID = new(big.Int)
-	}
-
 	return &Signer{
 		address: address,
 		chainID: chainID,
 		client:  clefClient,
-		signer:  eth.NewEIP155Signer(chainID),
+		signer:  eth.LatestSignerForChainID(chainID),
 	}, nil
 }
 
@@ -85,6 +118,52 @@ func (s *Signer) Hash(tx *common.Transaction) common.Hash {
 	return common.NewHash(ethHash.Bytes())
 }
 
+// PublicKey implements the Signer interface. Clef does not expose public keys, so the public key is recovered from the
+// signature of a message with a random nonce, which must be approved in Clef like any other signing request. Since the
+// nonce is random, the signature is of no use to anyone who intercepts it. The public key is cached, so approval is
+// only requested once.
+// @return The public key of the account, or an error if signing fails or the signature is not from the account
+func (s *Signer) PublicKey() (*ecdsa.PublicKey, error) {
+	s.publicKeyMu.Lock()
+	defer s.publicKeyMu.Unlock()
+
+	if s.publicKey != nil {
+		return s.publicKey, nil
+	}
+
+	nonce := make([]byte, publicKeyNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate public key request nonce: %w", err)
+	}
+
+	msg := []byte(fmt.Sprintf("%s\nNonce: %x", publicKeyMessage, nonce))
+	sig, err := s.SignMessage(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign public key request: %w", err)
+	}
+	if len(sig) != 65 {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+
+	// Clef returns the recovery id offset by 27
+	recoverable := append([]byte(nil), sig...)
+	if recoverable[64] >= 27 {
+		recoverable[64] -= 27
+	}
+
+	hash := crypto.HashMessage(msg)
+	pub, err := crypto.RecoverPublicKey(hash, recoverable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if recovered := crypto.PubkeyToAddress(*pub); !recovered.Equals(s.address) {
+		return nil, fmt.Errorf("public key of %s does not match the signer address %s", recovered.Hex(), s.address.Hex())
+	}
+
+	s.publicKey = pub
+	return pub, nil
+}
+
 // SignMessage implements the Signer interface
 // @param msg The message bytes to sign
 // @return The signature bytes, or an error if signing fails
@@ -105,6 +184,13 @@ func (s *Signer) SignMessage(msg []byte) ([]byte, error) {
 // @param tx The transaction to sign
 // @return The signed transaction, or an error if signing fails
 func (s *Signer) SignTransaction(tx *common.Transaction) (*common.SignedTransaction, error) {
+	if tx.Typed() && tx.ChainID == nil {
+		// Typed transactions commit to the chain ID, so use the chain ID of the signer if none is set
+		withChainID := *tx
+		withChainID.ChainID = s.chainID
+		tx = &withChainID
+	}
+
 	var result signedTransaction
 
 	args := tx.ToMap()
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"fmt"
+)
+
+// SignLargeMessage signs data of any length without passing the data to the signer as a personal message. The data is
+// hashed with Keccak256, and the hash is signed with the LargeMessagePrefix domain prefix, so verifiers must recover
+// the signer from crypto.HashLargeMessage of the data. The prefix ensures that the signature is not valid for a
+// transaction or personal message, even if the data is attacker-supplied. The Signer must implement
+// LargeMessageSigner, as the private key Signer does.
+//
+// @param signer The signer used to sign the data
+// @param data The data to sign
+// @return The 65-byte signature of the large message hash of the data and nil error on success
+// @return nil and error if the signer cannot sign large messages or signing fails
+func SignLargeMessage(signer Signer, data []byte) ([]byte, error) {
+	largeSigner, ok := signer.(LargeMessageSigner)
+	if !ok {
+		return nil, fmt.Errorf("signer cannot sign large messages")
+	}
+
+	sig, err := largeSigner.SignLargeMessage(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign large message: %w", err)
+	}
+
+	return sig, nil
+}
@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// SignOffline signs a fully specified transaction without any network access, so it can be signed on an air-gapped
+// machine and broadcast later with the Client method SendRawTransaction. Unlike PrepareTx, the nonce, gas limit, and
+// gas price (or fee cap of a dynamic fee transaction) are not filled in, so the caller must provide them. The Signer
+// must also not require network access to sign (e.g. a KeySigner created with a known chain ID).
+//
+// @param tx The fully specified transaction to sign
+// @param signer The signer used to sign the transaction
+// @return The signed transaction and nil error on success
+// @return nil and error if the transaction is incomplete or cannot be signed
+func SignOffline(tx *common.Transaction, signer Signer) (*common.SignedTransaction, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("no transaction provided")
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("signer is required for signing transactions")
+	}
+	if tx.Gas == 0 {
+		return nil, fmt.Errorf("gas limit is required for offline signing")
+	}
+	if tx.GasPrice == nil && tx.GasFeeCap == nil {
+		return nil, fmt.Errorf("gas price is required for offline signing")
+	}
+
+	signed, err := signer.SignTransaction(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	return signed, nil
+}
@@ -0,0 +1,78 @@
+package privatekey
+
+import (
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// SignerType selects the transaction signing rules used by a Signer.
+type SignerType int
+
+const (
+	// SignerTypeLatest signs all known transaction types, and is the default
+	SignerTypeLatest SignerType = iota
+
+	// SignerTypeHomestead signs legacy transactions without replay protection
+	SignerTypeHomestead
+
+	// SignerTypeEIP155 signs legacy transactions with EIP-155 replay protection
+	SignerTypeEIP155
+
+	// SignerTypeLondon signs legacy, EIP-2930 access list, and EIP-1559 dynamic fee transactions
+	SignerTypeLondon
+)
+
+// ethSigner returns the eth.Signer implementing the signing rules of the SignerType.
+//
+// @param chainID Chain ID to use for the signer
+// @return The eth.Signer for the SignerType
+func (t SignerType) ethSigner(chainID *big.Int) eth.Signer {
+	switch t {
+	case SignerTypeHomestead:
+		return eth.NewHomesteadSigner()
+	case SignerTypeEIP155:
+		return eth.NewEIP155Signer(chainID)
+	case SignerTypeLondon:
+		return eth.NewLondonSigner(chainID)
+	default:
+		return eth.LatestSignerForChainID(chainID)
+	}
+}
+
+// Option is a functional option for configuring a new Signer.
+type Option func(*Signer)
+
+// WithEthSigner sets the eth.Signer used to hash and sign transactions, for networks with signing rules that are not
+// covered by a SignerType. The eth.Signer should use the same chain ID as the Signer.
+//
+// @param signer The eth.Signer to use
+// @return An Option function that sets the eth.Signer of a Signer
+func WithEthSigner(signer eth.Signer) Option {
+	return func(s *Signer) {
+		s.signer = signer
+	}
+}
+
+// WithMessagePrefix sets the prefix of personal messages signed with SignMessage, for chains that use a prefix other
+// than the EIP-191 Ethereum prefix, which is the default. The prefix is followed by the message length and the
+// message before hashing, so signatures must be verified with the same prefix (e.g. with crypto.HashMessageWithPrefix).
+//
+// @param prefix The personal message prefix
+// @return An Option function that sets the message prefix of a Signer
+func WithMessagePrefix(prefix string) Option {
+	return func(s *Signer) {
+		s.messagePrefix = prefix
+	}
+}
+
+// WithSignerType sets the transaction signing rules used by the Signer. By default, all known transaction types are
+// supported.
+//
+// @param signerType The SignerType to use
+// @return An Option function that sets the SignerType of a Signer
+func WithSignerType(signerType SignerType) Option {
+	return func(s *Signer) {
+		s.signer = signerType.ethSigner(s.chainID)
+	}
+}
@@ -19,7 +19,7 @@ import (
 // For production systems with high security requirements, consider using a custom Signer with a hardware
 // security module or key management service.
 type Signer struct {
-	// address is the Radius address associated with this signer
+	// address is the Radius address associated with this signer, derived from the key at construction
 	address common.Address
 
 	// chainID is the network chain ID used for EIP-155 transaction signing
@@ -28,6 +28,9 @@ type Signer struct {
 	// key is the ECDSA private key used for signing operations
 	key *ecdsa.PrivateKey
 
+	// messagePrefix is the prefix of personal messages signed with SignMessage
+	messagePrefix string
+
 	// signer is the underlying Ethereum signer implementation
 	signer eth.Signer
 }
@@ -36,22 +39,41 @@ type Signer struct {
 //
 // @param key The ECDSA private key to use for signing
 // @param client The Radius client used to retrieve the chain ID
+// @param opts Optional signer configuration options
 // @return A new Signer instance configured with the provided key and chain ID
-func New(key *ecdsa.PrivateKey, client auth.SignerClient) *Signer {
+func New(key *ecdsa.PrivateKey, client auth.SignerClient, opts ...Option) *Signer {
 	chainID, err := client.ChainID(context.Background())
 	if err != nil {
 		chainID = new(big.Int)
 	}
 
-	return &Signer{
-		address: crypto.PubkeyToAddress(key.PublicKey),
-		chainID: chainID,
-		key:     key,
-		signer:  eth.NewEIP155Signer(chainID),
+	return NewWithChainID(key, chainID, opts...)
+}
+
+// NewWithChainID creates a new Signer with the given private key and a known chain ID. Unlike New, no client is
+// required, so the Signer can be used to sign transactions offline (e.g. on an air-gapped machine).
+//
+// @param key The ECDSA private key to use for signing
+// @param chainID The chain ID of the network the transactions are signed for
+// @param opts Optional signer configuration options
+// @return A new Signer instance configured with the provided key and chain ID
+func NewWithChainID(key *ecdsa.PrivateKey, chainID *big.Int, opts ...Option) *Signer {
+	s := &Signer{
+		address:       crypto.PubkeyToAddress(key.PublicKey),
+		chainID:       chainID,
+		key:           key,
+		messagePrefix: crypto.EthereumMessagePrefix,
+		signer:        SignerTypeLatest.ethSigner(chainID),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
-// Address implements the Signer interface
+// Address implements the Signer interface. The address is derived from the public key once, when the Signer is
+// created, so calling Address on hot paths does not hash the key.
 // @return The Radius Address associated with the Signer
 func (s *Signer) Address() common.Address {
 	return s.address
@@ -72,34 +94,46 @@ func (s *Signer) Hash(tx *common.Transaction) common.Hash {
 	return common.NewHash(ethHash.Bytes())
 }
 
+// PublicKey implements the Signer interface
+// @return The public key of the private key used for signing
+func (s *Signer) PublicKey() (*ecdsa.PublicKey, error) {
+	return &s.key.PublicKey, nil
+}
+
+// SignLargeMessage implements the LargeMessageSigner interface
+// @param data The data to sign
+// @return The signature bytes, or an error if signing fails
+func (s *Signer) SignLargeMessage(data []byte) ([]byte, error) {
+	return crypto.Sign(crypto.HashLargeMessage(data), s.key)
+}
+
 // SignMessage implements the Signer interface
 // @param msg The message bytes to sign
 // @return The signature bytes, or an error if signing fails
 func (s *Signer) SignMessage(msg []byte) ([]byte, error) {
-	return crypto.Sign(crypto.Keccak256(
-		[]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(msg), msg)),
-	), s.key)
+	return crypto.Sign(crypto.HashMessageWithPrefix(s.messagePrefix, msg), s.key)
 }
 
 // SignTransaction implements the Signer interface
 // @param tx The transaction to sign
 // @return The signed transaction, or an error if signing fails
 func (s *Signer) SignTransaction(tx *common.Transaction) (*common.SignedTransaction, error) {
+	if tx.Typed() && tx.ChainID == nil {
+		// Typed transactions commit to the chain ID, so use the chain ID of the signer if none is set
+		withChainID := *tx
+		withChainID.ChainID = s.chainID
+		tx = &withChainID
+	}
+
 	hash := s.Hash(tx)
 	sig, err := crypto.Sign(hash.Bytes(), s.key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
-	v := new(big.Int).SetBytes([]byte{sig[64] + 27})
-	if s.chainID.Sign() != 0 {
-		v = v.Add(v, new(big.Int).Mul(s.chainID, big.NewInt(2)))
-		v = v.Add(v, big.NewInt(8))
-	}
-
 	// Serialize the signed transaction
 	ethTx := tx.EthTransaction()
-	ethSignedTx, err := ethTx.WithSignature(s.signer, append(sig[:64], sig[64]+27))
+	ethSignedTx, err := ethTx.WithSignature(s.signer, sig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
@@ -108,10 +142,13 @@ func (s *Signer) SignTransaction(tx *common.Transaction) (*common.SignedTransact
 		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
+	// The signer encodes V as required by the transaction type (EIP-155 for legacy transactions)
+	v, r, sigS := ethSignedTx.RawSignatureValues()
+
 	return &common.SignedTransaction{
 		Transaction: tx,
-		R:           new(big.Int).SetBytes(sig[:32]),
-		S:           new(big.Int).SetBytes(sig[32:64]),
+		R:           r,
+		S:           sigS,
 		V:           v,
 		Serialized:  serialized,
 	}, nil
@@ -4,6 +4,7 @@ package auth
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"math/big"
 	"net/http"
 
@@ -26,6 +27,11 @@ type Signer interface {
 	// @return The transaction hash
 	Hash(tx *common.Transaction) common.Hash
 
+	// PublicKey returns the public key of the Signer, e.g. to register it with a service or to encrypt data for the
+	// Signer (ECIES)
+	// @return The public key, or an error if it is not available
+	PublicKey() (*ecdsa.PublicKey, error)
+
 	// SignMessage signs the given message using the EIP-191 standard
 	// @param msg The message bytes to sign
 	// @return The signature bytes, or an error if signing fails
@@ -37,6 +43,15 @@ type Signer interface {
 	SignTransaction(tx *common.Transaction) (*common.SignedTransaction, error)
 }
 
+// LargeMessageSigner is an optional interface of Signers that can sign data of any length, by signing the hash returned
+// by crypto.HashLargeMessage. It is implemented by the private key Signer, and used by SignLargeMessage.
+type LargeMessageSigner interface {
+	// SignLargeMessage signs the large message hash of the given data
+	// @param data The data to sign
+	// @return The 65-byte signature in the [R || S || V] format, or an error if signing fails
+	SignLargeMessage(data []byte) ([]byte, error)
+}
+
 // SignerClient is an interface for the Radius Client methods that may be required by the Signer.
 // This interface is implemented by the main Radius Client.
 type SignerClient interface {
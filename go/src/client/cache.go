@@ -0,0 +1,62 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// MemoryCache is a Cache that stores values in memory for the lifetime of the process. A MemoryCache is safe for
+// concurrent use, and is enabled on a Client with the WithCache option.
+type MemoryCache struct {
+	// mu guards values
+	mu sync.RWMutex
+
+	// values maps each key to its cached value
+	values map[string][]byte
+}
+
+// NewMemoryCache creates a new MemoryCache with no cached values.
+//
+// @return A new MemoryCache instance
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{values: make(map[string][]byte)}
+}
+
+// Get implements the Cache interface
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Set implements the Cache interface
+func (m *MemoryCache) Set(key string, value []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = value
+}
+
+// chainIDCacheKey returns the Cache key of the chain ID of the node at the given URL. The chain ID is cached per URL,
+// so a Cache can be shared by clients of different networks.
+//
+// @param url URL of the Radius node
+// @return The Cache key
+func chainIDCacheKey(url string) string {
+	return "chainId:" + url
+}
+
+// codeCacheKey returns the Cache key of the contract code at the given address on the given network.
+//
+// @param chainID Chain ID of the network
+// @param address Address of the contract
+// @return The Cache key
+func codeCacheKey(chainID *big.Int, address common.Address) string {
+	return "code:" + chainID.String() + ":" + strings.ToLower(address.Hex())
+}
@@ -4,10 +4,15 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/common"
@@ -16,16 +21,67 @@ import (
 	"github.com/radiustechsystems/sdk/go/src/transport"
 )
 
+// defaultGasMarginPercent is the default percentage added to gas estimates as a safety margin
+const defaultGasMarginPercent = 20
+
+// maxConcurrentReceipts is the maximum number of receipts waited for at a time by WaitForReceipts
+const maxConcurrentReceipts = 8
+
+// maxReplaceAttempts is the maximum number of times an underpriced replacement transaction is re-signed and resent
+const maxReplaceAttempts = 3
+
 // Client is used to interact with the Radius platform.
 // It serves as the main entry point for working with the Radius ecosystem.
 // It provides methods for account management, contract deployment and interaction,
 // transaction handling, and querying Radius state.
 type Client struct {
+	// cache stores immutable chain data, if set
+	cache Cache
+
+	// chainIDKey is the Cache key of the chain ID of the node
+	chainIDKey string
+
+	// deployValidation enables checking deployed contract code against the contract ABI
+	deployValidation bool
+
+	// estimateTransfers enables gas estimation for plain value transfers
+	estimateTransfers bool
+
+	// gasMarginPercent is the percentage added to gas estimates as a safety margin
+	gasMarginPercent int
+
+	// gasObserver is called with the gas limit and gas used of each mined transaction, if set
+	gasObserver GasObserver
+
 	// httpClient is the HTTP client used for making API requests
 	httpClient *http.Client
 
 	// ethClient is the Ethereum client used to communicate with Radius
 	ethClient *eth.Client
+
+	// logger is used to log warnings, if set
+	logger transport.Logf
+
+	// maxGas is the maximum gas limit of estimated transactions
+	maxGas uint64
+
+	// nativeDecimals is the number of decimals of the native currency
+	nativeDecimals int
+
+	// nonceManager tracks sender nonces locally, if set
+	nonceManager *NonceManager
+
+	// pollInterval is the interval between polls for new logs when watching events over HTTP
+	pollInterval time.Duration
+
+	// replaceBumpPercent is the percentage to bump the gas price of underpriced replacement transactions by
+	replaceBumpPercent int
+
+	// resolver is used to resolve names to addresses
+	resolver Resolver
+
+	// txIndexer is used to look up the transactions of an account, if set
+	txIndexer TxIndexer
 }
 
 // New creates a new Radius Client with the given URL and ClientOption(s).
@@ -35,35 +91,117 @@ type Client struct {
 // @return New Radius Client instance and nil error on success
 // @return nil and error if client creation fails
 func New(url string, opts ...Option) (*Client, error) {
+	return newClient(url, nil, opts)
+}
+
+// NewWithEndpoints creates a new Radius Client that fails over between the given URLs of the same Radius network.
+// Requests are sent to one endpoint at a time, and read requests that fail with a connection error are retried on the
+// next endpoint. Transactions are only resent to the next endpoint if the connection could not be established, so a
+// transaction is never submitted twice, and an error is returned instead. See transport.FailoverRoundTripper.
+//
+// The chain ID of each endpoint is checked when the Client is created, even if only one endpoint is given. Endpoints
+// that cannot be reached are skipped, so the Client can be created while an endpoint is down, but the Client cannot be
+// created if no endpoint can be reached (including a single endpoint that is down), or the endpoints report different
+// chain IDs.
+//
+// @param urls URLs of the Radius nodes, in the order they are tried
+// @param opts Optional client configuration options
+// @return New Radius Client instance and nil error on success
+// @return nil and error if no endpoint can be reached, the chain IDs don't match, or client creation fails
+func NewWithEndpoints(urls []string, opts ...Option) (*Client, error) {
+	if len(urls) == 0 {
+		return nil, fmt.Errorf("failed to create Radius client: at least one endpoint is required")
+	}
+	return newClient(urls[0], urls, opts)
+}
+
+// newClient creates a new Radius Client with the given URL and ClientOption(s). If endpoints are given, their chain IDs
+// are checked, and the Client fails over between them if there is more than one.
+//
+// @param url URL of the Radius node
+// @param endpoints URLs of the Radius nodes to check and fail over between, or nil to connect to url unchecked
+// @param opts Optional client configuration options
+// @return New Radius Client instance and nil error on success
+// @return nil and error if client creation fails
+func newClient(url string, endpoints []string, opts []Option) (*Client, error) {
 	options := &Options{
-		httpClient: &http.Client{},
+		gasMarginPercent: defaultGasMarginPercent,
+		httpClient:       &http.Client{},
+		nativeDecimals:   common.EtherDecimals,
+		pollInterval:     defaultPollInterval,
 	}
 
 	for _, opt := range opts {
 		opt(options)
 	}
 
+	if options.maxGas == 0 {
+		options.maxGas = common.MaxGas
+	}
+
+	if options.transport != nil {
+		options.httpClient.Transport = options.transport
+	}
 	if options.httpClient.Transport == nil {
 		options.httpClient.Transport = http.DefaultTransport
 	}
 
-	if options.logger != nil || options.interceptor != nil {
+	if options.logger != nil || options.interceptor != nil || options.requestID != nil ||
+		options.requestInterceptor != nil || options.userAgent != "" {
 		irt := transport.InterceptingRoundTripper{
-			Proxied:     options.httpClient.Transport,
-			Interceptor: options.interceptor,
-			Logf:        options.logger,
+			Proxied:            options.httpClient.Transport,
+			Interceptor:        options.interceptor,
+			Logf:               options.logger,
+			LogLevel:           options.logLevel,
+			MaxBodyLog:         options.maxBodyLog,
+			RequestID:          options.requestID,
+			RequestInterceptor: options.requestInterceptor,
+			UserAgent:          options.userAgent,
 		}
 		options.httpClient.Transport = irt
 	}
 
+	if options.nativeDecimals < 0 {
+		return nil, fmt.Errorf("failed to create Radius client: native decimals must not be negative, got %d",
+			options.nativeDecimals)
+	}
+
+	if len(endpoints) > 0 {
+		if err := checkChainIDs(endpoints, options.httpClient); err != nil {
+			return nil, fmt.Errorf("failed to create Radius client: %w", err)
+		}
+	}
+
+	if len(endpoints) > 1 {
+		failover, err := transport.NewFailoverRoundTripper(endpoints, options.httpClient.Transport)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Radius client: %w", err)
+		}
+		options.httpClient.Transport = failover
+	}
+
 	ethClient, err := eth.NewClient(url, options.httpClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create Radius client: %w", err)
 	}
 
 	return &Client{
-		httpClient: options.httpClient,
-		ethClient:  ethClient,
+		cache:              options.cache,
+		chainIDKey:         chainIDCacheKey(url),
+		deployValidation:   options.deployValidation,
+		estimateTransfers:  options.estimateTransfers,
+		gasMarginPercent:   options.gasMarginPercent,
+		gasObserver:        options.gasObserver,
+		httpClient:         options.httpClient,
+		ethClient:          ethClient,
+		logger:             options.logger,
+		maxGas:             options.maxGas,
+		nativeDecimals:     options.nativeDecimals,
+		nonceManager:       options.nonceManager,
+		pollInterval:       options.pollInterval,
+		replaceBumpPercent: options.replaceBumpPercent,
+		resolver:           options.resolver,
+		txIndexer:          options.txIndexer,
 	}, nil
 }
 
@@ -81,22 +219,146 @@ func (c *Client) BalanceAt(ctx context.Context, address common.Address) (*big.In
 	return balance, nil
 }
 
+// BalanceEtherAt returns the balance of the given address as a decimal ether amount (e.g. "1.5"), for display. The
+// balance is formatted with the native decimals set with WithNativeDecimals, as by FormatNative.
+//
+// @param ctx Context for the request
+// @param address Address to check the balance for
+// @return Balance as a decimal ether amount and nil error on success
+// @return Empty string and error if the balance cannot be retrieved from the network
+func (c *Client) BalanceEtherAt(ctx context.Context, address common.Address) (string, error) {
+	balance, err := c.BalanceAt(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	return c.FormatNative(balance), nil
+}
+
+// BalancesAtBlock returns the balances of the given addresses in wei, all at the same block, so the balances are
+// consistent with each other even while the state is changing. The balances are retrieved with a single batch of
+// requests.
+//
+// @param ctx Context for the request
+// @param addresses Addresses to check the balances for
+// @param blockNumber Block number to get the balances at, or nil for the latest block
+// @return Map of balances in wei keyed by address and nil error on success
+// @return nil and error if any of the balances cannot be retrieved from the network
+func (c *Client) BalancesAtBlock(
+	ctx context.Context,
+	addresses []common.Address,
+	blockNumber *big.Int,
+) (map[common.Address]*big.Int, error) {
+	result := make(map[common.Address]*big.Int, len(addresses))
+	if len(addresses) == 0 {
+		return result, nil
+	}
+
+	if blockNumber == nil {
+		// Pin the latest block, so that a new block between requests cannot make the balances inconsistent
+		latest, err := c.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		blockNumber = new(big.Int).SetUint64(latest)
+	}
+
+	block := fmt.Sprintf("0x%x", blockNumber)
+	balances := make([]eth.HexBig, len(addresses))
+	batch := make([]eth.BatchElem, len(addresses))
+	for i, address := range addresses {
+		batch[i] = eth.BatchElem{
+			Method: "eth_getBalance",
+			Args:   []interface{}{address.EthAddress(), block},
+			Result: &balances[i],
+		}
+	}
+
+	if err := c.ethClient.Client().BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("failed to get balance of %s: %w", addresses[i].Hex(), elem.Error)
+		}
+		result[addresses[i]] = balances[i].ToInt()
+	}
+
+	return result, nil
+}
+
+// Bind returns a Contract for the contract at the given address with the given ABI, e.g. a contract whose address was
+// discovered at runtime from a factory event. The Client does not check that code exists at the address, and the
+// Contract is not tied to this Client, so it is equivalent to contracts.New; use CodeAt to check that the contract is
+// deployed.
+//
+// @param address Address of the contract
+// @param abi ABI of the contract
+// @return A Contract for the contract at the address
+func (c *Client) Bind(address common.Address, abi *common.ABI) *contracts.Contract {
+	return contracts.New(address, abi)
+}
+
+// BlockNumber returns the number of the most recent block.
+//
+// @param ctx Context for the request
+// @return The latest block number and nil error on success
+// @return 0 and error if the block number cannot be retrieved from the network
+func (c *Client) BlockNumber(ctx context.Context) (uint64, error) {
+	number, err := c.ethClient.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get block number: %w", err)
+	}
+	return number, nil
+}
+
 // Call executes a contract method call and returns the decoded result. This is used for read-only contract methods,
 // and does not require a transaction to be sent to Radius. Alternatively, you can use the contracts.Contract method
 // Call, which provides a more convenient interface for interacting with smart contracts.
 func (c *Client) Call(ctx context.Context, contract *contracts.Contract, method string, args ...interface{}) ([]interface{}, error) {
-	if contract.ABI == nil {
-		return nil, fmt.Errorf("contract ABI is required")
+	result, err := c.call(ctx, contract, method, args...)
+	if err != nil {
+		return nil, err
 	}
 
-	address := contract.Address()
-	if address.Equals(common.ZeroAddress()) {
-		return nil, fmt.Errorf("contract address is required")
+	decoded, err := contract.ABI.Unpack(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
 	}
 
-	data, err := contract.ABI.Pack(method, args...)
+	return decoded, nil
+}
+
+// CallMap executes a contract method call and returns the decoded result keyed by output name. This is used for
+// read-only contract methods that return multiple values. Alternatively, you can use the contracts.Contract method
+// CallMap, which provides a more convenient interface for interacting with smart contracts.
+func (c *Client) CallMap(ctx context.Context, contract *contracts.Contract, method string, args ...interface{}) (map[string]interface{}, error) {
+	result, err := c.call(ctx, contract, method, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode method call: %w", err)
+		return nil, err
+	}
+
+	decoded, err := contract.ABI.UnpackToMap(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// CallRaw executes a contract call with prebuilt calldata and returns the raw, undecoded result. The contract ABI is
+// not used, so it may be nil. Alternatively, you can use the contracts.Contract method CallRaw, which builds the
+// calldata from a function selector and encoded arguments.
+//
+// @param ctx Context for the request
+// @param contract Contract instance to interact with
+// @param data Calldata to send to the contract
+// @return Raw result of the call and nil error on success
+// @return nil and error if the contract address is missing or zero, or the call fails
+func (c *Client) CallRaw(ctx context.Context, contract *contracts.Contract, data []byte) ([]byte, error) {
+	address := contract.Address()
+	if address.Equals(common.ZeroAddress()) {
+		return nil, fmt.Errorf("contract address is required")
 	}
 
 	params := txParams{
@@ -119,62 +381,170 @@ func (c *Client) Call(ctx context.Context, contract *contracts.Contract, method
 		return nil, fmt.Errorf("contract call failed: %w", err)
 	}
 
-	decoded, err := contract.ABI.Unpack(method, result)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode result: %w", err)
-	}
-
-	return decoded, nil
+	return result, nil
 }
 
-// ChainID returns the chain ID of the connected Radius network.
+// ChainID returns the chain ID of the connected Radius network. If a Cache is set with WithCache, the chain ID is
+// requested from the node only once.
 //
 // @param ctx Context for the request
 // @return Chain ID of the network and nil error on success
 // @return nil and error if the chain ID cannot be retrieved from the network
 func (c *Client) ChainID(ctx context.Context) (*big.Int, error) {
+	if c.cache != nil {
+		if cached, ok := c.cache.Get(c.chainIDKey); ok {
+			return new(big.Int).SetBytes(cached), nil
+		}
+	}
+
 	chainID, err := c.ethClient.ChainID(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get chain ID: %w", err)
 	}
 
+	if c.cache != nil {
+		c.cache.Set(c.chainIDKey, chainID.Bytes())
+	}
+
 	return chainID, nil
 }
 
-// CodeAt returns the contract code at the given address.
+// CodeAt returns the contract code at the given address. If a Cache is set with WithCache, deployed code is requested
+// from the node only once per chain ID and address, and the returned code is a copy that can be modified safely.
 //
 // @param ctx Context for the request
 // @param address Address of the contract to retrieve code for
 // @return Contract bytecode and nil error on success
 // @return nil and error if the code cannot be retrieved from the network
 func (c *Client) CodeAt(ctx context.Context, address common.Address) ([]byte, error) {
+	var key string
+	if c.cache != nil {
+		// The same address can hold different code on each network, so the code is cached per chain ID
+		chainID, err := c.ChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		key = codeCacheKey(chainID, address)
+		if cached, ok := c.cache.Get(key); ok {
+			return bytes.Clone(cached), nil
+		}
+	}
+
 	code, err := c.ethClient.CodeAt(ctx, address.EthAddress(), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get code: %w", err)
 	}
+
+	// Addresses without code may have a contract deployed to them later, so only deployed code is cached
+	if c.cache != nil && len(code) > 0 {
+		c.cache.Set(key, bytes.Clone(code))
+	}
+
 	return code, nil
 }
 
+// CodeHash returns the Keccak-256 hash of the code at the given address, which identifies the runtime code of a
+// contract. Addresses without code have the hash of empty code.
+//
+// @param ctx Context for the request
+// @param address Address of the contract to hash the code of
+// @return Hash of the contract code and nil error on success
+// @return Empty hash and error if the code cannot be retrieved from the network
+func (c *Client) CodeHash(ctx context.Context, address common.Address) (common.Hash, error) {
+	code, err := c.CodeAt(ctx, address)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.NewHash(eth.Keccak256(code)), nil
+}
+
+// CreateAccessList generates an EIP-2930 access list for the given transaction with eth_createAccessList, by
+// simulating it against the latest block and recording the addresses and storage keys it accesses. The result can be
+// set as the AccessList of the transaction before it is signed, or passed to NewAccessListTransaction.
+//
+// @param ctx Context for the request
+// @param from The sender of the transaction, since the storage accessed by a contract often depends on msg.sender
+// @param tx The transaction to generate the access list for
+// @return The generated access list and nil error on success
+// @return nil and error if the access list cannot be generated (e.g. the transaction reverts)
+func (c *Client) CreateAccessList(ctx context.Context, from common.Address, tx *common.Transaction) (common.AccessList, error) {
+	args := map[string]interface{}{
+		"from": from.Hex(),
+		"data": fmt.Sprintf("0x%x", tx.Data),
+	}
+	if tx.To != nil {
+		args["to"] = tx.To.Hex()
+	}
+	if tx.Value != nil {
+		args["value"] = fmt.Sprintf("0x%x", tx.Value)
+	}
+	if tx.GasPrice != nil {
+		args["gasPrice"] = fmt.Sprintf("0x%x", tx.GasPrice)
+	}
+	if tx.Gas != 0 {
+		args["gas"] = fmt.Sprintf("0x%x", tx.Gas)
+	}
+	if tx.AccessList != nil {
+		args["accessList"] = tx.AccessList.EthAccessList()
+	}
+
+	var result struct {
+		AccessList eth.AccessList `json:"accessList"`
+		Error      string         `json:"error"`
+	}
+	if err := c.ethClient.Client().CallContext(ctx, &result, "eth_createAccessList", args, common.BlockTagLatest); err != nil {
+		return nil, fmt.Errorf("failed to create access list: %w", err)
+	}
+	if result.Error != "" {
+		return nil, fmt.Errorf("failed to create access list: %s", result.Error)
+	}
+
+	return common.AccessListFromEth(result.AccessList), nil
+}
+
 // DeployContract deploys the given EVM smart contract bytecode to Radius. If the contract has a constructor, the
-// ABI and constructor arguments must be provided.
+// ABI and constructor arguments must be provided. With the WithDeployValidation option, the deployed code is checked
+// against the ABI.
 func (c *Client) DeployContract(ctx context.Context, signer auth.Signer, bytecode []byte, abi *common.ABI, args ...interface{}) (*contracts.Contract, error) {
+	return c.DeployContractWithProgress(ctx, signer, bytecode, abi, nil, args...)
+}
+
+// DeployContractWithProgress deploys a contract like DeployContract, and calls the progress function as the deployment
+// enters each stage, in order: DeployStageEstimating, DeployStageSigning, DeployStageBroadcasting, DeployStageWaiting,
+// and DeployStageMined. This lets CLIs report the progress of long-running deployments. The progress function is
+// called synchronously, so it should return quickly. If the deployment fails, the later stages are not reported.
+//
+// @param ctx Context for the request
+// @param signer The signer used to deploy the contract
+// @param bytecode The contract creation bytecode
+// @param abi The contract ABI, which is required if the contract has a constructor
+// @param progress Function called with the name of each stage, or nil
+// @param args Arguments to pass to the contract constructor
+// @return The deployed contract and nil error on success
+// @return nil and error if the constructor arguments cannot be encoded, or the deployment fails
+func (c *Client) DeployContractWithProgress(
+	ctx context.Context,
+	signer auth.Signer,
+	bytecode []byte,
+	abi *common.ABI,
+	progress func(stage string),
+	args ...interface{},
+) (*contracts.Contract, error) {
 	if signer == nil {
 		return nil, fmt.Errorf("signer is required for deploying contracts")
 	}
 
-	data := bytecode
-	if len(args) > 0 && abi != nil {
-		encodedConstructorArgs, err := abi.Pack("", args...)
-		if err != nil {
-			return nil, fmt.Errorf("failed to encode constructor arguments: %w", err)
-		}
-		data = append(data, encodedConstructorArgs...)
+	data, err := deploymentData(bytecode, abi, args...)
+	if err != nil {
+		return nil, err
 	}
 
 	receipt, err := c.prepareAndSendTx(ctx, txParams{
-		data:   data,
-		signer: signer,
-		value:  big.NewInt(0),
+		data:     data,
+		method:   "constructor",
+		progress: progress,
+		signer:   signer,
+		value:    big.NewInt(0),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to deploy contract: %w", err)
@@ -182,109 +552,537 @@ func (c *Client) DeployContract(ctx context.Context, signer auth.Signer, bytecod
 	if receipt == nil {
 		return nil, fmt.Errorf("failed to deploy contract: no receipt returned")
 	}
-	if receipt.Status != 1 {
+	if !receipt.Succeeded() {
 		return nil, fmt.Errorf("failed to deploy contract: status %d, transaction hash %s", receipt.Status, receipt.TxHash)
 	}
 
+	if c.deployValidation {
+		c.validateDeployment(ctx, receipt.ContractAddress, abi)
+	}
+
 	return contracts.New(receipt.ContractAddress, abi), nil
 }
 
-// EstimateGas estimates the gas cost of the given transaction. This is handled automatically by the Execute, Send,
-// and Transact methods, so you only need to call this method if you need to get the gas cost manually.
-func (c *Client) EstimateGas(ctx context.Context, tx *common.Transaction) (uint64, error) {
-	estimate, err := c.ethClient.EstimateGas(ctx, eth.CallMsg{
-		To:    common.EthAddressFromRadiusAddress(tx.To),
-		Data:  tx.Data,
-		Value: tx.Value,
-	})
+// DeployIfNotExists deploys the given EVM smart contract bytecode to Radius, unless code already exists at the expected
+// address, in which case the existing contract is returned without sending a transaction. This makes deployment
+// scripts safe to rerun. Only CREATE deployments are supported: if no code exists, the expected address must be the
+// address of the next contract created by the signer (see common.CreateAddress), using the same nonce the deployment
+// transaction will use. A CREATE2 address (see common.CreateAddress2) is only accepted if the contract already exists,
+// since this method cannot deploy through a CREATE2 factory.
+//
+// @param ctx Context for the request
+// @param signer The signer used to deploy the contract
+// @param expectedAddr The address the contract is expected to be deployed at
+// @param abi The contract ABI, which is required if the contract has a constructor
+// @param bytecode The contract creation bytecode
+// @param args Arguments to pass to the contract constructor
+// @return The existing or newly deployed contract and nil error on success
+// @return nil and error if the contract would not be deployed at the expected address, or the deployment fails
+func (c *Client) DeployIfNotExists(
+	ctx context.Context,
+	signer auth.Signer,
+	expectedAddr common.Address,
+	abi *common.ABI,
+	bytecode []byte,
+	args ...interface{},
+) (*contracts.Contract, error) {
+	if signer == nil {
+		return nil, fmt.Errorf("signer is required for deploying contracts")
+	}
+
+	code, err := c.CodeAt(ctx, expectedAddr)
 	if err != nil {
-		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+		return nil, err
+	}
+	if len(code) > 0 {
+		return contracts.New(expectedAddr, abi), nil
 	}
 
-	// Apply safety margin of 20% to the estimated gas cost
-	margin := estimate / 5
-	gas := estimate + margin
+	// Peek at the nonce the same way the deployment transaction will get it, so nonces reserved by a NonceManager are
+	// taken into account
+	nonce, err := c.nextNonce(ctx, txParams{}, signer.Address())
+	if err != nil {
+		return nil, err
+	}
+	if next := common.CreateAddress(signer.Address(), nonce); next != expectedAddr {
+		return nil, fmt.Errorf("no code at %s, and the next contract deployed by the signer would be at %s",
+			expectedAddr.Hex(), next.Hex())
+	}
 
-	// Limit gas to maxGas
-	if gas > common.MaxGas {
-		gas = common.MaxGas
+	contract, err := c.DeployContract(ctx, signer, bytecode, abi, args...)
+	if err != nil {
+		return nil, err
+	}
+	if deployed := contract.Address(); deployed != expectedAddr {
+		return nil, fmt.Errorf("contract deployed at %s instead of the expected %s", deployed.Hex(), expectedAddr.Hex())
 	}
 
-	return gas, nil
+	return contract, nil
 }
 
-// Execute executes a contract method call and returns the transaction receipt. This is used for state-changing contract
-// methods, and requires a transaction to be sent to Radius. A more convenient interface for interacting with smart
-// contracts is provided by the contracts.Contract method Execute.
-func (c *Client) Execute(ctx context.Context, contract *contracts.Contract, signer auth.Signer, method string, args ...interface{}) (*common.Receipt, error) {
+// EstimateContractGas estimates the gas cost of executing a contract method with the given value, using the signer
+// address as the sender. A more convenient interface is provided by the contracts.Contract methods EstimateGas and
+// EstimateGasWithValue.
+func (c *Client) EstimateContractGas(
+	ctx context.Context,
+	contract *contracts.Contract,
+	signer auth.Signer,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (uint64, error) {
 	if contract.ABI == nil {
-		return nil, fmt.Errorf("contract ABI is required")
+		return 0, fmt.Errorf("contract ABI is required")
 	}
 
 	address := contract.Address()
 	if address.Equals(common.ZeroAddress()) {
-		return nil, fmt.Errorf("contract address is required")
+		return 0, fmt.Errorf("contract address is required")
 	}
 
 	data, err := contract.ABI.Pack(method, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode method call: %w", err)
+		return 0, fmt.Errorf("failed to encode method call: %w", err)
 	}
 
-	return c.prepareAndSendTx(ctx, txParams{
-		to:     &address,
-		data:   data,
-		signer: signer,
-		value:  big.NewInt(0),
-	})
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	from := common.ZeroAddress()
+	if signer != nil {
+		from = signer.Address()
+	}
+
+	return c.estimateGas(ctx, from, &common.Transaction{
+		Data:  data,
+		To:    &address,
+		Value: value,
+	}, TxOptions{})
 }
 
-// HTTPClient returns the underlying HTTP client used by the Radius Client.
-func (c *Client) HTTPClient() *http.Client {
-	return c.httpClient
+// EstimateGas estimates the gas cost of the given transaction. This is handled automatically by the Execute, Send,
+// and Transact methods, so you only need to call this method if you need to get the gas cost manually.
+func (c *Client) EstimateGas(ctx context.Context, tx *common.Transaction) (uint64, error) {
+	return c.estimateGas(ctx, common.ZeroAddress(), tx, TxOptions{})
 }
 
-// PendingNonceAt returns the pending nonce of the given address. In most cases, you should not need to call this
-// method directly.
-func (c *Client) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
-	nonce, err := c.ethClient.PendingNonceAt(ctx, address.EthAddress())
-	if err != nil {
-		return 0, fmt.Errorf("failed to get nonce: %w", err)
-	}
-	return nonce, nil
+// Execute executes a contract method call and returns the transaction receipt. This is used for state-changing contract
+// methods, and requires a transaction to be sent to Radius. A more convenient interface for interacting with smart
+// contracts is provided by the contracts.Contract method Execute.
+func (c *Client) Execute(ctx context.Context, contract *contracts.Contract, signer auth.Signer, method string, args ...interface{}) (*common.Receipt, error) {
+	return c.ExecuteWithValue(ctx, contract, signer, big.NewInt(0), method, args...)
 }
 
-// Send sends value to the recipient address, and returns the Radius transaction Receipt.
-func (c *Client) Send(
+// ExecuteRaw executes a contract transaction with prebuilt calldata, and returns the Radius transaction Receipt. The
+// contract ABI is not used, so it may be nil. Alternatively, you can use the contracts.Contract method ExecuteRaw,
+// which builds the calldata from a function selector and encoded arguments.
+//
+// @param ctx Context for the request
+// @param contract Contract instance to interact with
+// @param signer The signer used to sign the transaction
+// @param data Calldata to send to the contract
+// @return Transaction receipt and nil error on success
+// @return nil and error if the contract address is missing or zero, or the transaction fails
+func (c *Client) ExecuteRaw(
 	ctx context.Context,
+	contract *contracts.Contract,
 	signer auth.Signer,
-	recipient common.Address,
-	value *big.Int,
+	data []byte,
 ) (*common.Receipt, error) {
-	if signer == nil {
-		return nil, fmt.Errorf("signer is required for sending transactions")
+	address := contract.Address()
+	if address.Equals(common.ZeroAddress()) {
+		return nil, fmt.Errorf("contract address is required")
 	}
 
 	receipt, err := c.prepareAndSendTx(ctx, txParams{
+		to:     &address,
+		data:   data,
 		signer: signer,
-		to:     &recipient,
-		value:  value,
+		value:  big.NewInt(0),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("transaction failed: %w", err)
-	}
-	if receipt == nil {
-		return nil, fmt.Errorf("transaction failed: no receipt returned")
-	}
-	if receipt.Status != 1 {
-		return nil, fmt.Errorf("transaction failed: %v", receipt)
+		return nil, err
 	}
 
 	return receipt, nil
 }
 
-// Transact sends a signed transaction to the Radius platform, and returns the Radius transaction Receipt.
-func (c *Client) Transact(
+// ExecuteWithOptions executes a contract method call with the given value, gas, gas price, and nonce overrides, and
+// returns the transaction receipt. A more convenient interface for interacting with smart contracts is provided by the
+// contracts.Contract method ExecuteWithOptions.
+func (c *Client) ExecuteWithOptions(
+	ctx context.Context,
+	contract *contracts.Contract,
+	signer auth.Signer,
+	method string,
+	opts contracts.ExecuteOptions,
+	args ...interface{},
+) (*common.Receipt, error) {
+	if contract.ABI == nil {
+		return nil, fmt.Errorf("contract ABI is required")
+	}
+
+	address := contract.Address()
+	if address.Equals(common.ZeroAddress()) {
+		return nil, fmt.Errorf("contract address is required")
+	}
+
+	data, err := contract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode method call: %w", err)
+	}
+
+	value := opts.Value
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	// Skip gas estimation if the contract has a gas preset for the method, unless the gas limit is overridden
+	gas, preset := opts.Gas, false
+	if gas == 0 {
+		gas, preset = contract.GasPresets[method]
+	}
+
+	receipt, err := c.prepareAndSendTx(ctx, txParams{
+		to:      &address,
+		data:    data,
+		method:  method,
+		signer:  signer,
+		options: TxOptions{Gas: gas, GasMultiplier: opts.GasMultiplier, GasPrice: opts.GasPrice, Nonce: opts.Nonce},
+		value:   value,
+	})
+	if err != nil {
+		// Only point at the preset if the transaction ran out of gas, and not e.g. if it reverted or was never sent
+		if preset && isOutOfGas(receipt, gas, err) {
+			return nil, fmt.Errorf("%w (gas limit %d from the %s gas preset may be too low)", err, gas, method)
+		}
+		return nil, err
+	}
+
+	return receipt, nil
+}
+
+// ExecuteWithValue executes a payable contract method call with the given value, and returns the transaction receipt.
+// A more convenient interface for interacting with smart contracts is provided by the contracts.Contract method
+// ExecuteWithValue.
+func (c *Client) ExecuteWithValue(
+	ctx context.Context,
+	contract *contracts.Contract,
+	signer auth.Signer,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	return c.ExecuteWithOptions(ctx, contract, signer, method, contracts.ExecuteOptions{Value: value}, args...)
+}
+
+// FilterEvents returns the events with the given name emitted by the contract in the given block range, with the
+// event arguments decoded into the Data of each Event. Alternatively, you can use the contracts.Contract method
+// FilterEvents, which provides a more convenient interface for interacting with smart contracts.
+//
+// @param ctx Context for the request
+// @param contract Contract instance that emitted the events
+// @param event Name of the event in the contract ABI
+// @param fromBlock First block to search, or nil for the genesis block
+// @param toBlock Last block to search, or nil for the latest block
+// @return Decoded events in the order they were emitted and nil error on success
+// @return nil and error if the contract ABI is missing, the event is not found, or the logs cannot be retrieved
+func (c *Client) FilterEvents(
+	ctx context.Context,
+	contract *contracts.Contract,
+	event string,
+	fromBlock, toBlock *big.Int,
+) ([]common.Event, error) {
+	if contract.ABI == nil {
+		return nil, fmt.Errorf("contract ABI is required")
+	}
+
+	topics, err := contract.ABI.EventTopics(event)
+	if err != nil {
+		return nil, err
+	}
+
+	address := contract.Address()
+	logs, err := c.ethClient.FilterLogs(ctx, eth.FilterQuery{
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Addresses: []eth.Address{address.EthAddress()},
+		Topics:    topics,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter logs: %w", err)
+	}
+
+	events := make([]common.Event, len(logs))
+	for i := range logs {
+		if events[i], err = decodeEvent(contract, event, &logs[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return events, nil
+}
+
+// FormatNative converts an amount in the smallest unit of the native currency to a decimal amount (e.g. "1.5"), using
+// the native decimals set with WithNativeDecimals, or 18 decimals by default. Unlike common.FormatEther, which always
+// uses 18 decimals, this is correct for native currencies with other decimals. Use common.FormatUnits to format amounts
+// of other tokens.
+//
+// @param amount Amount in the smallest unit of the native currency
+// @return The decimal amount, without trailing fractional zeros
+func (c *Client) FormatNative(amount *big.Int) string {
+	return common.FormatUnits(amount, c.nativeDecimals)
+}
+
+// HTTPClient returns the underlying HTTP client used by the Radius Client.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// NativeDecimals returns the number of decimals of the native currency, set with WithNativeDecimals.
+//
+// @return The number of decimals of the native currency
+func (c *Client) NativeDecimals() int {
+	return c.nativeDecimals
+}
+
+// NonceAtTag returns the nonce of the given address at the given block tag. Use common.BlockTagLatest to get the
+// nonce of confirmed transactions only, or common.BlockTagPending to include pending transactions.
+//
+// @param ctx Context for the request
+// @param address Address to check the nonce for
+// @param blockTag Block tag to query the nonce at ("latest", "pending", "earliest", "safe", "finalized", or a hex
+// block number)
+// @return The nonce at the given block tag and nil error on success
+// @return 0 and error if the block tag is invalid, or the nonce cannot be retrieved from the network
+func (c *Client) NonceAtTag(ctx context.Context, address common.Address, blockTag string) (uint64, error) {
+	if err := validateBlockTag(blockTag); err != nil {
+		return 0, err
+	}
+
+	var nonce eth.HexUint64
+	if err := c.ethClient.Client().CallContext(ctx, &nonce, "eth_getTransactionCount", address.EthAddress(), blockTag); err != nil {
+		return 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	return uint64(nonce), nil
+}
+
+// PendingNonceAt returns the pending nonce of the given address. In most cases, you should not need to call this
+// method directly.
+func (c *Client) PendingNonceAt(ctx context.Context, address common.Address) (uint64, error) {
+	nonce, err := c.ethClient.PendingNonceAt(ctx, address.EthAddress())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// PrepareTx prepares a Radius transaction from the signer, setting the pending nonce of the signer and estimating the
+// gas limit. If a NonceManager is set with WithNonceManager, the next nonce it tracks is used, but not reserved, since
+// the transaction may never be sent. The transaction is returned unsigned, and can be signed and sent with Transact.
+// In most cases, you should use the Execute or Send methods instead, which prepare, sign, and send the transaction in
+// one step.
+//
+// @param ctx Context for the request
+// @param signer The signer whose address is used as the sender of the transaction
+// @param to Destination address of the transaction, or nil for contract creation
+// @param data Transaction data (bytecode for contract creation, or method call data)
+// @param value Amount of native currency to send with the transaction in wei
+// @return The prepared transaction and nil error on success
+// @return nil and error if the nonce cannot be retrieved or the gas estimation fails
+func (c *Client) PrepareTx(
+	ctx context.Context,
+	signer auth.Signer,
+	to *common.Address,
+	data []byte,
+	value *big.Int,
+) (*common.Transaction, error) {
+	return c.PrepareTxWithOptions(ctx, signer, to, data, value, TxOptions{})
+}
+
+// PrepareTxWithOptions prepares a Radius transaction like PrepareTx, applying the given per-transaction options. The
+// EIP-2718 type of the transaction is selected by the fee fields of the options: an EIP-1559 dynamic fee transaction
+// if GasFeeCap is set, an EIP-2930 access list transaction if AccessList is set, and a legacy transaction otherwise.
+// Signers set the chain ID of typed transactions, so the prepared transaction can be signed and sent with Transact
+// or SendRawTransaction like a legacy transaction.
+//
+// @param ctx Context for the request
+// @param signer The signer whose address is used as the sender of the transaction
+// @param to Destination address of the transaction, or nil for contract creation
+// @param data Transaction data (bytecode for contract creation, or method call data)
+// @param value Amount of native currency to send with the transaction in wei
+// @param opts Per-transaction options
+// @return The prepared transaction and nil error on success
+// @return nil and error if the fee options conflict, or the nonce cannot be retrieved or the gas estimation fails
+func (c *Client) PrepareTxWithOptions(
+	ctx context.Context,
+	signer auth.Signer,
+	to *common.Address,
+	data []byte,
+	value *big.Int,
+	opts TxOptions,
+) (*common.Transaction, error) {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	return c.prepareTx(ctx, txParams{
+		data:    data,
+		options: opts,
+		signer:  signer,
+		to:      to,
+		value:   value,
+	})
+}
+
+// Resolve returns the address for the given name. If the name is a hex address, it is returned as is. Otherwise, the
+// name is resolved using the Resolver configured with the WithResolver option.
+//
+// @param ctx Context for the request
+// @param name Name or hex address to resolve
+// @return The resolved address and nil error on success
+// @return Zero address and error if no resolver is configured or the name cannot be resolved
+func (c *Client) Resolve(ctx context.Context, name string) (common.Address, error) {
+	if address, err := common.AddressFromHex(name); err == nil {
+		return address, nil
+	}
+
+	if c.resolver == nil {
+		return common.ZeroAddress(), fmt.Errorf("no resolver configured for name %q", name)
+	}
+
+	address, err := c.resolver.Resolve(ctx, name)
+	if err != nil {
+		return common.ZeroAddress(), fmt.Errorf("failed to resolve name %q: %w", name, err)
+	}
+
+	return address, nil
+}
+
+// Send sends value to the recipient address, and returns the Radius transaction Receipt.
+func (c *Client) Send(
+	ctx context.Context,
+	signer auth.Signer,
+	recipient common.Address,
+	value *big.Int,
+) (*common.Receipt, error) {
+	if signer == nil {
+		return nil, fmt.Errorf("signer is required for sending transactions")
+	}
+
+	receipt, err := c.prepareAndSendTx(ctx, txParams{
+		signer: signer,
+		to:     &recipient,
+		value:  value,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("transaction failed: %w", err)
+	}
+	if receipt == nil {
+		return nil, fmt.Errorf("transaction failed: no receipt returned")
+	}
+	if !receipt.Succeeded() {
+		return nil, fmt.Errorf("transaction failed: %v", receipt)
+	}
+
+	return receipt, nil
+}
+
+// SendRawTransaction broadcasts a serialized signed transaction (e.g. the Serialized bytes of a transaction signed
+// offline with SignOffline) and returns its hash without waiting for it to be mined. WaitForReceipt can then be used
+// to wait for the transaction receipt.
+//
+// @param ctx Context for the request
+// @param raw The RLP-encoded signed transaction
+// @return Hash of the transaction and nil error on success
+// @return Empty hash and error if the transaction is rejected
+func (c *Client) SendRawTransaction(ctx context.Context, raw []byte) (common.Hash, error) {
+	var hash eth.Hash
+	if err := c.ethClient.Client().CallContext(ctx, &hash, "eth_sendRawTransaction", fmt.Sprintf("0x%x", raw)); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+	return common.NewHash(hash.Bytes()), nil
+}
+
+// SendToName sends value to the address the given name resolves to, and returns the Radius transaction Receipt. The
+// name is resolved using Resolve, so a hex address may also be given.
+func (c *Client) SendToName(
+	ctx context.Context,
+	signer auth.Signer,
+	name string,
+	value *big.Int,
+) (*common.Receipt, error) {
+	recipient, err := c.Resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Send(ctx, signer, recipient, value)
+}
+
+// SimulateDeploy runs the deployment of the given EVM smart contract bytecode with eth_call, without sending a
+// transaction, to check that the constructor would succeed with the given arguments. If the ABI has a constructor, the
+// arguments are encoded and appended to the bytecode, as with DeployContract.
+//
+// @param ctx Context for the request
+// @param from Address of the deployer
+// @param bytecode The contract creation bytecode
+// @param abi The contract ABI, which is required if the contract has a constructor
+// @param args Arguments to pass to the contract constructor
+// @return nil error if the deployment would succeed
+// @return *RevertError with the decoded revert reason if the constructor would revert
+// @return Error if the constructor arguments cannot be encoded or the simulation fails
+func (c *Client) SimulateDeploy(
+	ctx context.Context,
+	from common.Address,
+	bytecode []byte,
+	abi *common.ABI,
+	args ...interface{},
+) error {
+	data, err := deploymentData(bytecode, abi, args...)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.ethClient.CallContract(ctx, eth.CallMsg{
+		From: from.EthAddress(),
+		Data: data,
+	}, nil)
+	if revertErr, ok := asRevertError(err); ok {
+		return fmt.Errorf("contract deployment would fail: %w", revertErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to simulate contract deployment: %w", err)
+	}
+
+	return nil
+}
+
+// StorageAt returns the raw 32-byte value of the given storage slot of a contract. This can be used to read values
+// that are not exposed by the contract ABI, such as the implementation address of an EIP-1967 proxy.
+//
+// @param ctx Context for the request
+// @param address Address of the contract to read storage from
+// @param slot The storage slot to read
+// @param blockNumber Block number to read the storage at, or nil for the latest block
+// @return The storage value and nil error on success
+// @return nil and error if the storage cannot be retrieved from the network
+func (c *Client) StorageAt(
+	ctx context.Context,
+	address common.Address,
+	slot common.Hash,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	value, err := c.ethClient.StorageAt(ctx, address.EthAddress(), eth.BytesToHash(slot.Bytes()), blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage: %w", err)
+	}
+	return value, nil
+}
+
+// Transact sends a signed transaction to the Radius platform, and returns the Radius transaction Receipt.
+func (c *Client) Transact(
 	ctx context.Context,
 	signer auth.Signer,
 	tx *common.SignedTransaction,
@@ -297,22 +1095,43 @@ func (c *Client) Transact(
 		return nil, fmt.Errorf("no signed transaction provided")
 	}
 
-	ethTx := tx.EthSignedTransaction()
+	receipt, err := c.transact(ctx, signer, tx, nil, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := c.ethClient.SendTransaction(ctx, ethTx); err != nil {
+	return receipt, nil
+}
+
+// transact sends a signed transaction like Transact, and calls the progress function, if set, before the transaction
+// is sent, before waiting for its receipt, and once it is mined. If the nonce of the transaction was reserved from a
+// NonceManager, it is passed to sendTransaction to resync the nonce if necessary. If the transaction is mined but
+// failed, its receipt is returned along with the error.
+func (c *Client) transact(
+	ctx context.Context,
+	signer auth.Signer,
+	tx *common.SignedTransaction,
+	nonces *NonceManager,
+	progress func(stage string),
+) (*common.Receipt, error) {
+	reportProgress(progress, DeployStageBroadcasting)
+	tx, err := c.sendTransaction(ctx, signer, tx, nonces)
+	if err != nil {
 		return nil, fmt.Errorf("failed to send transaction: %w", err)
 	}
 
+	reportProgress(progress, DeployStageWaiting)
+	ethTx := tx.EthSignedTransaction()
 	receipt, err := eth.WaitMined(ctx, c.ethClient, ethTx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+		// The transaction was sent, so return its hash to allow the caller to resume waiting with WaitForReceipt
+		pending := &PendingTransactionError{Hash: common.NewHash(ethTx.Hash().Bytes()), Err: err}
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", pending)
 	}
 	if receipt == nil {
 		return nil, fmt.Errorf("failed to get transaction receipt: no receipt returned")
 	}
-	if receipt.Status != 1 {
-		return nil, fmt.Errorf("transaction failed: status %d, transaction hash %s", receipt.Status, receipt.TxHash)
-	}
+	reportProgress(progress, DeployStageMined)
 
 	from := signer.Address()
 	to := common.ZeroAddress()
@@ -321,23 +1140,241 @@ func (c *Client) Transact(
 	}
 	value := tx.Value
 
-	return common.ReceiptFromEthReceipt(receipt, from, to, value), nil
+	mined := common.ReceiptFromEthReceipt(receipt, from, to, value)
+	if receipt.Status != 1 {
+		// The failed receipt is returned with the error, so the gas used by the failed transaction can be observed
+		return mined, fmt.Errorf("transaction failed: status %d, transaction hash %s", receipt.Status, receipt.TxHash)
+	}
+
+	return mined, nil
+}
+
+// TransactionReceipt returns the Radius transaction Receipt of the transaction with the given hash, without waiting for
+// it to be mined. Nodes return no receipt for pending transactions, and some nodes briefly return no receipt even after
+// a transaction is mined, in which case an error wrapping ErrNotFound is returned, so it can be told apart from a
+// failed request and retried, or WaitForReceipt can be used instead.
+//
+// @param ctx Context for the requests
+// @param hash Hash of the transaction
+// @return Transaction receipt and nil error on success, which may have a failed status
+// @return nil and error wrapping ErrNotFound if the node has no receipt for the transaction
+// @return nil and error if the receipt or transaction cannot be retrieved from the network
+func (c *Client) TransactionReceipt(ctx context.Context, hash common.Hash) (*common.Receipt, error) {
+	receipt, err := c.ethClient.TransactionReceipt(ctx, eth.BytesToHash(hash.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	return c.receiptFromEth(ctx, receipt)
+}
+
+// VerifyCodeHash reports whether the Keccak-256 hash of the code at the given address matches the expected hash. This
+// guards against interacting with an impersonating contract, by allow-listing the code hashes of known contracts.
+//
+// @param ctx Context for the request
+// @param address Address of the contract to verify
+// @param expected Expected Keccak-256 hash of the runtime code of the contract
+// @return true if the code hash matches, false otherwise, and nil error on success
+// @return false and error if the code cannot be retrieved from the network
+func (c *Client) VerifyCodeHash(ctx context.Context, address common.Address, expected common.Hash) (bool, error) {
+	hash, err := c.CodeHash(ctx, address)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(hash.Bytes(), expected.Bytes()), nil
+}
+
+// WaitForNonce waits until the latest nonce of the given address reaches the target, i.e. until the transactions of
+// the address with nonces below the target are mined. The nonce is polled at the interval set with WithPollInterval.
+// This synchronizes operations that depend on earlier transactions, e.g. in test orchestration.
+//
+// @param ctx Context for the requests, which can be used to limit the time spent waiting
+// @param address Address of the account to wait for
+// @param target The nonce to wait for
+// @return nil once the latest nonce of the address is at least the target
+// @return error if the nonce cannot be retrieved, or the context is done before the target is reached
+func (c *Client) WaitForNonce(ctx context.Context, address common.Address, target uint64) error {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		nonce, err := c.NonceAtTag(ctx, address, common.BlockTagLatest)
+		if err != nil {
+			return err
+		}
+		if nonce >= target {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("nonce of %s is %d, waiting for %d: %w", address.Hex(), nonce, target, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForReceipt waits for the transaction with the given hash to be mined, and returns the Radius transaction
+// Receipt. This can be used to resume waiting for a transaction after Transact, Send, or Execute return a
+// PendingTransactionError, e.g. because the context deadline was exceeded before the transaction was mined. The receipt
+// is polled at the interval set with WithPollInterval until the context is done. Polling continues while the node
+// returns no receipt, even if the transaction is already mined, and while requests fail with transient errors, e.g.
+// a dropped connection, but stops if the node rejects the request itself, since it would fail again.
+//
+// @param ctx Context for the request, which can be used to limit the time spent waiting
+// @param hash Hash of the transaction to wait for
+// @return Transaction receipt and nil error on success
+// @return nil and error if the transaction cannot be found, is not mined before the context is done, or failed
+func (c *Client) WaitForReceipt(ctx context.Context, hash common.Hash) (*common.Receipt, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	ethHash := eth.BytesToHash(hash.Bytes())
+	var lastErr error
+	for {
+		receipt, err := c.ethClient.TransactionReceipt(ctx, ethHash)
+		if err == nil {
+			if receipt.Status != 1 {
+				return nil, fmt.Errorf("transaction failed: status %d, transaction hash %s", receipt.Status, receipt.TxHash)
+			}
+
+			var result *common.Receipt
+			if result, err = c.receiptFromEth(ctx, receipt); err == nil {
+				return result, nil
+			}
+		}
+		if isTerminalError(err) {
+			return nil, fmt.Errorf("failed to get transaction receipt: %w", &PendingTransactionError{Hash: hash, Err: err})
+		}
+		if !errors.Is(err, eth.ErrNotFound) && ctx.Err() == nil {
+			lastErr = err
+		}
+
+		select {
+		case <-ctx.Done():
+			waitErr := ctx.Err()
+			if lastErr != nil {
+				waitErr = fmt.Errorf("%w (last error: %v)", waitErr, lastErr)
+			}
+			return nil, fmt.Errorf("failed to get transaction receipt: %w", &PendingTransactionError{Hash: hash, Err: waitErr})
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitForReceipts waits for the transactions with the given hashes to be mined in parallel, e.g. after submitting a
+// batch of transactions, and returns their receipts in the order of the hashes. At most maxConcurrentReceipts
+// receipts are waited for at a time, to bound the load on the node. The receipts are returned as a ReceiptBatch, so
+// the batch can be reported on as a whole, e.g. with AllSucceeded and TotalGasUsed.
+//
+// @param ctx Context for the requests, which can be used to limit the time spent waiting
+// @param hashes Hashes of the transactions to wait for
+// @return The receipts in the order of the hashes and nil error on success
+// @return The receipts, with nil for each transaction that could not be waited for, and the errors of those
+// transactions joined, each annotated with its transaction hash
+func (c *Client) WaitForReceipts(ctx context.Context, hashes []common.Hash) (common.ReceiptBatch, error) {
+	receipts := make(common.ReceiptBatch, len(hashes))
+	errs := make([]error, len(hashes))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentReceipts)
+	for i, hash := range hashes {
+		wg.Add(1)
+		go func(i int, hash common.Hash) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			receipt, err := c.WaitForReceipt(ctx, hash)
+			if err != nil {
+				errs[i] = fmt.Errorf("transaction %s: %w", hash.Hex(), err)
+				return
+			}
+			receipts[i] = receipt
+		}(i, hash)
+	}
+	wg.Wait()
+
+	return receipts, errors.Join(errs...)
+}
+
+// WaitForStatus waits for the transaction with the given hash to be mined, and returns its TransactionStatus.
+func (c *Client) WaitForStatus(ctx context.Context, hash common.Hash) (common.TransactionStatus, error) {
+	receipt, err := eth.WaitMinedHash(ctx, c.ethClient, eth.BytesToHash(hash.Bytes()))
+	if err != nil {
+		return common.StatusFailed, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	return common.TransactionStatus(receipt.Status), nil
+}
+
+// call executes a contract method call and returns the raw, undecoded result.
+func (c *Client) call(ctx context.Context, contract *contracts.Contract, method string, args ...interface{}) ([]byte, error) {
+	if contract.ABI == nil {
+		return nil, fmt.Errorf("contract ABI is required")
+	}
+
+	data, err := contract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode method call: %w", err)
+	}
+
+	return c.CallRaw(ctx, contract, data)
+}
+
+// estimateGas estimates the gas cost of the given transaction sent from the given address, and applies a safety margin
+// to the estimate unless the transaction options skip it.
+func (c *Client) estimateGas(ctx context.Context, from common.Address, tx *common.Transaction, opts TxOptions) (uint64, error) {
+	estimate, err := c.ethClient.EstimateGas(ctx, eth.CallMsg{
+		From:       from.EthAddress(),
+		To:         common.EthAddressFromRadiusAddress(tx.To),
+		Data:       tx.Data,
+		Value:      tx.Value,
+		AccessList: tx.AccessList.EthAccessList(),
+	})
+	if revertErr, ok := asRevertError(err); ok {
+		return 0, fmt.Errorf("failed to estimate gas: %w", revertErr)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+
+	if opts.SkipGasMargin {
+		return estimate, nil
+	}
+
+	// Apply the multiplier or the safety margin to the estimated gas cost
+	gas := estimate
+	if opts.GasMultiplier != 0 {
+		if opts.GasMultiplier < 1 {
+			return 0, fmt.Errorf("gas multiplier %g must be at least 1", opts.GasMultiplier)
+		}
+		if multiplied := math.Ceil(float64(estimate) * opts.GasMultiplier); multiplied < float64(c.maxGas) {
+			gas = uint64(multiplied)
+		} else {
+			gas = c.maxGas
+		}
+	} else if c.gasMarginPercent > 0 {
+		gas += estimate * uint64(c.gasMarginPercent) / 100
+	}
+
+	// Limit gas to maxGas
+	if gas > c.maxGas {
+		gas = c.maxGas
+	}
+
+	return gas, nil
 }
 
 // prepareTx prepares a Radius transaction, ensuring that the nonce is set correctly. In most cases, you should use the
 // Execute or Send methods instead, which provide a more convenient interface.
 func (c *Client) prepareTx(ctx context.Context, params txParams) (*common.Transaction, error) {
-	var (
-		err   error
-		nonce uint64
-	)
+	var err error
 
-	// Get the pending nonce for the signer address, if necessary
+	from := common.ZeroAddress()
 	if params.signer != nil {
-		nonce, err = c.PendingNonceAt(ctx, params.signer.Address())
-		if err != nil {
-			return nil, fmt.Errorf("failed to get nonce: %w", err)
-		}
+		from = params.signer.Address()
 	}
 
 	// Must set Transaction.To value to nil if it is the zero address
@@ -346,43 +1383,261 @@ func (c *Client) prepareTx(ctx context.Context, params txParams) (*common.Transa
 		to = nil
 	}
 
-	// Create the initial transaction used to estimate gas
+	// Create the initial transaction used to estimate gas, with the transaction type selected by the fee fields
 	tx := &common.Transaction{
-		Data:     params.data,
-		Nonce:    nonce,
-		Gas:      0,
-		GasPrice: big.NewInt(0),
-		To:       to,
-		Value:    params.value,
+		AccessList: params.options.AccessList,
+		Data:       params.data,
+		Gas:        0,
+		To:         to,
+		Value:      params.value,
 	}
 
-	// Estimate gas cost for the transaction
-	tx.Gas, err = c.EstimateGas(ctx, tx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to estimate gas: %w", err)
+	switch {
+	case params.options.GasFeeCap != nil:
+		if params.options.GasPrice != nil {
+			return nil, fmt.Errorf("gas price cannot be set with a gas fee cap")
+		}
+		tx.GasFeeCap = params.options.GasFeeCap
+		tx.GasTipCap = params.options.GasTipCap
+		if tx.GasTipCap == nil {
+			tx.GasTipCap = big.NewInt(0)
+		}
+	case params.options.GasTipCap != nil:
+		return nil, fmt.Errorf("gas tip cap requires a gas fee cap")
+	default:
+		tx.GasPrice = params.options.GasPrice
+		if tx.GasPrice == nil {
+			tx.GasPrice = big.NewInt(0)
+		}
+	}
+
+	// Use the gas limit if given, or estimate the gas cost of the transaction
+	switch {
+	case params.options.Gas != 0:
+		tx.Gas = params.options.Gas
+	case c.isPlainTransfer(tx):
+		// Plain value transfers have a fixed gas cost, so the estimation request is skipped
+		tx.Gas = common.TransferGas
+	default:
+		tx.Gas, err = c.estimateGas(ctx, from, tx, params.options)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+	}
+
+	// Get the nonce for the signer address last, so that a nonce is never reserved for a transaction that fails to
+	// prepare
+	if params.signer != nil {
+		tx.Nonce, err = c.nextNonce(ctx, params, from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
 	}
 
 	return tx, nil
 }
 
+// isPlainTransfer reports whether the transaction is a plain value transfer with no data, which has a fixed gas cost,
+// unless gas estimation of transfers is enabled with WithTransferGasEstimation. The code of the recipient is not
+// checked, so that no request is made, and transfers to contracts with a receive or fallback function need estimation
+// to be enabled.
+//
+// @param tx The transaction to check
+// @return true if the transaction can be sent with the TransferGas limit, false otherwise
+func (c *Client) isPlainTransfer(tx *common.Transaction) bool {
+	return !c.estimateTransfers && len(tx.Data) == 0 && tx.To != nil && tx.AccessList == nil
+}
+
+// nextNonce returns the nonce of a transaction from the given sender: the nonce set in the transaction options, or the
+// next nonce tracked by the NonceManager, or else the pending nonce fetched from the node. The nonce is only reserved
+// from the NonceManager if the transaction parameters ask for it, i.e. if the transaction is about to be sent.
+//
+// @param ctx Context for the request
+// @param params The transaction parameters
+// @param from Address of the sender
+// @return The nonce and nil error on success
+// @return 0 and error if the nonce cannot be fetched
+func (c *Client) nextNonce(ctx context.Context, params txParams, from common.Address) (uint64, error) {
+	switch {
+	case params.options.Nonce != nil:
+		return *params.options.Nonce, nil
+	case c.nonceManager != nil && params.reserveNonce:
+		return c.nonceManager.next(ctx, c.PendingNonceAt, from)
+	case c.nonceManager != nil:
+		return c.nonceManager.peek(ctx, c.PendingNonceAt, from)
+	default:
+		return c.PendingNonceAt(ctx, from)
+	}
+}
+
 // prepareAndSendTx prepares and sends a Radius transaction, ensuring that the transaction is signed correctly. In
-// most cases, you should use the Execute or Send methods instead, which provide a more convenient interface.
+// most cases, you should use the Execute or Send methods instead, which provide a more convenient interface. If the
+// transaction is mined but failed, its receipt is returned along with the error, as by transact.
 func (c *Client) prepareAndSendTx(ctx context.Context, params txParams) (*common.Receipt, error) {
 	if params.signer == nil {
 		return nil, fmt.Errorf("signer is required for sending transactions")
 	}
 
+	reportProgress(params.progress, DeployStageEstimating)
+	params.reserveNonce = true
 	tx, err := c.prepareTx(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
+	reportProgress(params.progress, DeployStageSigning)
 	signedTx, err := params.signer.SignTransaction(tx)
 	if err != nil {
+		if c.nonceManager != nil && params.options.Nonce == nil {
+			// The reserved nonce was not used, so release it for the next transaction
+			c.nonceManager.release(params.signer.Address(), tx.Nonce)
+		}
 		return nil, fmt.Errorf("failed to sign transaction: %w", err)
 	}
 
-	return c.Transact(ctx, params.signer, signedTx)
+	// Only nonces reserved from the NonceManager are resynced, since a nonce set by the caller may be intentional, e.g.
+	// to replace a pending transaction
+	var nonces *NonceManager
+	if params.options.Nonce == nil {
+		nonces = c.nonceManager
+	}
+
+	receipt, err := c.transact(ctx, params.signer, signedTx, nonces, params.progress)
+	if receipt != nil && c.gasObserver != nil {
+		c.gasObserver(params.method, tx.Gas, receipt.GasUsed)
+	}
+
+	return receipt, err
+}
+
+// receiptFromEth converts an Ethereum receipt to a Radius receipt, looking up the sender, recipient, and value of its
+// transaction.
+func (c *Client) receiptFromEth(ctx context.Context, receipt *eth.Receipt) (*common.Receipt, error) {
+	tx, _, err := c.ethClient.TransactionByHash(ctx, receipt.TxHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	sender, err := eth.Sender(eth.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction sender: %w", err)
+	}
+
+	from := common.NewAddress(sender.Bytes())
+	to := common.ZeroAddress()
+	if tx.To() != nil {
+		to = common.NewAddress(tx.To().Bytes())
+	}
+
+	return common.ReceiptFromEthReceipt(receipt, from, to, tx.Value()), nil
+}
+
+// sendTransaction sends a signed transaction to Radius. If the node rejects the transaction as an underpriced
+// replacement and automatic replacement is enabled with the WithAutoReplace option, the transaction is re-signed with
+// the same nonce and a bumped gas price, or a bumped fee cap and tip cap for dynamic fee transactions, and sent again.
+// If the node rejects the transaction because its nonce is too low and the nonce was reserved from a NonceManager, the
+// nonce is resynced from the node and the transaction is re-signed with the corrected nonce and sent once more. A nonce
+// set by the caller is never changed, so the error is returned instead.
+//
+// @param ctx Context for the request
+// @param signer The signer used to re-sign the transaction, if necessary
+// @param tx The signed transaction to send
+// @param nonces The NonceManager the nonce of the transaction was reserved from, or nil if it was set by the caller
+// @return The signed transaction that was accepted by the node and nil error on success
+// @return nil and error if the transaction cannot be sent
+func (c *Client) sendTransaction(
+	ctx context.Context,
+	signer auth.Signer,
+	tx *common.SignedTransaction,
+	nonces *NonceManager,
+) (*common.SignedTransaction, error) {
+	err := c.ethClient.SendTransaction(ctx, tx.EthSignedTransaction())
+
+	if isNonceTooLow(err) && nonces != nil {
+		nonce, syncErr := nonces.resync(ctx, c.PendingNonceAt, signer.Address())
+		if syncErr != nil {
+			return nil, fmt.Errorf("failed to resync nonce: %w", syncErr)
+		}
+
+		resubmission := *tx.Transaction
+		resubmission.Nonce = nonce
+
+		tx, err = signer.SignTransaction(&resubmission)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign resubmitted transaction: %w", err)
+		}
+
+		err = c.ethClient.SendTransaction(ctx, tx.EthSignedTransaction())
+	}
+
+	for attempt := 0; isReplacementUnderpriced(err) && c.replaceBumpPercent > 0; attempt++ {
+		if attempt == maxReplaceAttempts {
+			return nil, fmt.Errorf("replacement still underpriced after %d attempts: %w", attempt, err)
+		}
+
+		// Dynamic fee transactions are priced by their fee cap and tip cap, so both are bumped instead of the gas price
+		replacement := *tx.Transaction
+		if replacement.GasFeeCap != nil {
+			replacement.GasFeeCap = bumpGasPrice(tx.GasFeeCap, c.replaceBumpPercent)
+			replacement.GasTipCap = bumpGasPrice(tx.GasTipCap, c.replaceBumpPercent)
+		} else {
+			replacement.GasPrice = bumpGasPrice(tx.GasPrice, c.replaceBumpPercent)
+		}
+
+		tx, err = signer.SignTransaction(&replacement)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign replacement transaction: %w", err)
+		}
+
+		err = c.ethClient.SendTransaction(ctx, tx.EthSignedTransaction())
+	}
+	if err != nil {
+		if nonces != nil {
+			// The reserved nonce was not used, so fetch it from the node again for the next transaction
+			nonces.Reset(signer.Address())
+		}
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// bumpGasPrice increases the gas price by the given percentage. The bumped gas price is always greater than the
+// original, so a zero gas price is bumped to 1 wei.
+//
+// @param price The original gas price
+// @param percent The percentage to increase the gas price by
+// @return The bumped gas price
+func bumpGasPrice(price *big.Int, percent int) *big.Int {
+	if price == nil {
+		price = big.NewInt(0)
+	}
+
+	bumped := new(big.Int).Mul(price, big.NewInt(int64(100+percent)))
+	bumped.Div(bumped, big.NewInt(100))
+	if bumped.Cmp(price) <= 0 {
+		bumped.Add(price, big.NewInt(1))
+	}
+
+	return bumped
+}
+
+// validateBlockTag checks that the block tag is a named block tag or a hex block number.
+//
+// @param blockTag The block tag to validate
+// @return nil error if the block tag is valid, or error otherwise
+func validateBlockTag(blockTag string) error {
+	switch blockTag {
+	case common.BlockTagLatest, common.BlockTagPending, common.BlockTagEarliest, common.BlockTagSafe,
+		common.BlockTagFinalized:
+		return nil
+	}
+
+	if _, err := eth.DecodeHexUint64(blockTag); err != nil {
+		return fmt.Errorf("invalid block tag %q: must be latest, pending, earliest, safe, finalized, or a hex block number",
+			blockTag)
+	}
+	return nil
 }
 
 // txParams contains the parameters required to prepare and send a Radius transaction.
@@ -391,12 +1646,65 @@ type txParams struct {
 	// data is the transaction data (bytecode for contract creation or method call data)
 	data []byte
 
+	// method is the name of the contract method called by the transaction, if any, which is passed to the gas observer
+	method string
+
 	// signer is used to sign the transaction
 	signer auth.Signer
 
+	// options contains optional per-transaction overrides
+	options TxOptions
+
+	// progress is called with the name of each stage of sending the transaction, if set
+	progress func(stage string)
+
+	// reserveNonce reserves the nonce of the transaction from the NonceManager, if set, because it is about to be sent
+	reserveNonce bool
+
 	// to is the destination address for the transaction (nil for contract creation)
 	to *common.Address
 
 	// value is the amount of native currency to send with the transaction
 	value *big.Int
 }
+
+// deploymentData returns the contract creation bytecode with the encoded constructor arguments appended.
+//
+// @param bytecode The contract creation bytecode
+// @param abi The contract ABI, which is required if the contract has a constructor
+// @param args Arguments to pass to the contract constructor
+// @return The deployment data and nil error on success
+// @return nil and error if the constructor arguments cannot be encoded
+func deploymentData(bytecode []byte, abi *common.ABI, args ...interface{}) ([]byte, error) {
+	if len(args) == 0 || abi == nil {
+		return bytecode, nil
+	}
+
+	encodedConstructorArgs, err := abi.Pack("", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode constructor arguments: %w", err)
+	}
+
+	data := make([]byte, 0, len(bytecode)+len(encodedConstructorArgs))
+	return append(append(data, bytecode...), encodedConstructorArgs...), nil
+}
+
+// decodeEvent decodes a log emitted by the contract as the event with the given name.
+//
+// @param contract Contract instance that emitted the log
+// @param event Name of the event in the contract ABI
+// @param log The log to decode
+// @return The decoded event and nil error on success
+// @return Empty event and error if the log cannot be decoded as the event
+func decodeEvent(contract *contracts.Contract, event string, log *eth.Log) (common.Event, error) {
+	decoded := common.EventsFromEthLogs([]*eth.Log{log})[0]
+
+	data, err := contract.ABI.UnpackEvent(event, decoded)
+	if err != nil {
+		return common.Event{}, fmt.Errorf("failed to decode event: %w", err)
+	}
+
+	decoded.Name = event
+	decoded.Data = data
+	return decoded, nil
+}
@@ -0,0 +1,90 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// opPush1 is the EVM PUSH1 opcode. PUSHn is opPush1 + n - 1.
+const opPush1 = 0x60
+
+// The stages of a deployment reported by DeployContractWithProgress, in order.
+const (
+	// DeployStageEstimating is reported before the nonce is retrieved and the gas of the deployment is estimated
+	DeployStageEstimating = "estimating"
+
+	// DeployStageSigning is reported before the deployment transaction is signed
+	DeployStageSigning = "signing"
+
+	// DeployStageBroadcasting is reported before the signed transaction is sent to the node
+	DeployStageBroadcasting = "broadcasting"
+
+	// DeployStageWaiting is reported before waiting for the transaction to be mined
+	DeployStageWaiting = "waiting"
+
+	// DeployStageMined is reported once the receipt of the transaction is received
+	DeployStageMined = "mined"
+)
+
+// reportProgress calls the progress function with the given stage, if the function is set.
+//
+// @param progress The progress function, or nil
+// @param stage The name of the stage
+func reportProgress(progress func(stage string), stage string) {
+	if progress != nil {
+		progress(stage)
+	}
+}
+
+// validateDeployment checks that the runtime code of a deployed contract matches its ABI, and logs a warning with
+// the logger if it does not. This is enabled with the WithDeployValidation option.
+//
+// @param ctx Context for the request
+// @param address Address of the deployed contract
+// @param abi ABI the contract was deployed with
+func (c *Client) validateDeployment(ctx context.Context, address common.Address, abi *common.ABI) {
+	if c.logger == nil || abi == nil {
+		return
+	}
+
+	code, err := c.CodeAt(ctx, address)
+	if err != nil {
+		c.logger("Warning: failed to validate the ABI of the contract deployed at %s: %v", address.Hex(), err)
+		return
+	}
+
+	if missing := missingSelectors(code, abi); len(missing) > 0 {
+		c.logger("Warning: the code of the contract deployed at %s does not dispatch the ABI methods %s; "+
+			"the ABI may not match the bytecode", address.Hex(), strings.Join(missing, ", "))
+	}
+}
+
+// missingSelectors returns the names of the ABI methods whose function selectors do not appear in the runtime code.
+// Solidity and Vyper dispatch calls by comparing the calldata selector with each selector pushed onto the stack, so a
+// selector that is never pushed is a strong hint that the ABI does not belong to the code. This is a heuristic: proxies
+// and contracts with a fallback dispatcher implement methods without pushing their selectors.
+//
+// @param code The runtime code of the contract
+// @param abi The ABI of the contract
+// @return The sorted names of the methods whose selectors are missing from the code
+func missingSelectors(code []byte, abi *common.ABI) []string {
+	var missing []string
+	for name, selector := range abi.MethodSelectors() {
+		// Compilers push selectors with the shortest PUSH opcode, so leading zero bytes are dropped
+		value := bytes.TrimLeft(selector[:], "\x00")
+		push := append([]byte{byte(opPush1 + len(value) - 1)}, value...)
+		if len(value) == 0 || !bytes.Contains(code, push) {
+			missing = append(missing, name)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
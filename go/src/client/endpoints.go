@@ -0,0 +1,72 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// endpointCheckTimeout is the maximum time to wait for the chain ID of each endpoint when creating a Client with
+// multiple endpoints
+const endpointCheckTimeout = 10 * time.Second
+
+// checkChainIDs checks that the given endpoints are connected to the same network, by comparing the chain IDs they
+// report. Endpoints that cannot be reached are skipped.
+//
+// @param endpoints URLs of the Radius nodes
+// @param httpClient HTTP client used to send the requests
+// @return nil if the reachable endpoints report the same chain ID
+// @return error if no endpoint can be reached, or the chain IDs don't match
+func checkChainIDs(endpoints []string, httpClient *http.Client) error {
+	// Copy the HTTP client, since the transport of the given client is replaced once the endpoints are checked
+	probe := *httpClient
+
+	var chainID *big.Int
+	var first string
+	var errs []error
+	for _, endpoint := range endpoints {
+		id, err := endpointChainID(endpoint, &probe)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %s: %w", endpoint, err))
+			continue
+		}
+
+		if chainID == nil {
+			chainID, first = id, endpoint
+		} else if chainID.Cmp(id) != 0 {
+			return fmt.Errorf("chain ID mismatch: endpoint %s has chain ID %s, but endpoint %s has chain ID %s",
+				first, chainID, endpoint, id)
+		}
+	}
+
+	if chainID == nil {
+		return fmt.Errorf("no endpoint can be reached: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// endpointChainID returns the chain ID reported by the given endpoint.
+//
+// @param endpoint URL of the Radius node
+// @param httpClient HTTP client used to send the request
+// @return The chain ID and nil error on success
+// @return nil and error if the chain ID cannot be retrieved
+func endpointChainID(endpoint string, httpClient *http.Client) (*big.Int, error) {
+	ethClient, err := eth.NewClient(endpoint, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	defer ethClient.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), endpointCheckTimeout)
+	defer cancel()
+	return ethClient.ChainID(ctx)
+}
@@ -0,0 +1,174 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// ErrMethodUnsupported is returned when the node does not support a JSON-RPC method, e.g. because the debug namespace
+// is disabled. Use errors.Is to check for it.
+var ErrMethodUnsupported = errors.New("method not supported by the node")
+
+// ErrNotFound is returned when a requested item, such as a transaction receipt, is not known to the node yet, as
+// opposed to an error of the request itself. Use errors.Is to check for it.
+var ErrNotFound = eth.ErrNotFound
+
+// JSON-RPC error codes returned when the request itself is invalid, so retrying it fails again
+const (
+	// errCodeInvalidRequest is returned when the request is not a valid JSON-RPC request
+	errCodeInvalidRequest = -32600
+
+	// errCodeMethodNotFound is returned when a method does not exist or is not available
+	errCodeMethodNotFound = -32601
+
+	// errCodeInvalidParams is returned when the parameters of a method are invalid
+	errCodeInvalidParams = -32602
+)
+
+// errNonceTooLow is the error message returned by a node when a transaction uses a nonce that has already been used
+// by a mined transaction from the same sender
+const errNonceTooLow = "nonce too low"
+
+// errOutOfGas is the error message returned by a node when a transaction or call runs out of gas
+const errOutOfGas = "out of gas"
+
+// errIntrinsicGasTooLow is the error message returned by a node when the gas limit of a transaction does not cover
+// its intrinsic gas cost
+const errIntrinsicGasTooLow = "intrinsic gas too low"
+
+// errReplacementUnderpriced is the error message returned by a node when a replacement transaction does not pay a
+// high enough gas price to replace the pending transaction with the same nonce
+const errReplacementUnderpriced = "replacement transaction underpriced"
+
+// PendingTransactionError is returned when a transaction was sent to Radius, but waiting for its receipt failed, e.g.
+// because the context deadline was exceeded. The transaction may still be mined, so the Hash can be used to resume
+// waiting with Client.WaitForReceipt. Use errors.As to retrieve a PendingTransactionError from a returned error.
+type PendingTransactionError struct {
+	// Hash is the hash of the sent transaction
+	Hash common.Hash
+
+	// Err is the error that interrupted waiting for the receipt
+	Err error
+}
+
+// Error implements the error interface
+func (e *PendingTransactionError) Error() string {
+	return fmt.Sprintf("transaction %s is pending: %v", e.Hash.Hex(), e.Err)
+}
+
+// Unwrap returns the error that interrupted waiting for the receipt, so errors.Is(err, context.DeadlineExceeded)
+// reports whether the wait timed out.
+func (e *PendingTransactionError) Unwrap() error {
+	return e.Err
+}
+
+// RevertError is returned when a call or simulated transaction is reverted by the EVM, including when the gas of a
+// transaction cannot be estimated because it would revert. Use errors.As to retrieve a RevertError from a returned
+// error.
+type RevertError struct {
+	// Reason is the decoded revert reason, or empty if the revert data could not be decoded (e.g. a custom error)
+	Reason string
+
+	// Data is the raw revert data returned by the node
+	Data []byte
+}
+
+// Error implements the error interface
+func (e *RevertError) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("execution reverted: 0x%x", e.Data)
+	}
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// asRevertError returns the RevertError for the revert data carried by the error, if any.
+//
+// @param err Error returned by a JSON-RPC call
+// @return The RevertError and true if the error carries revert data, nil and false otherwise
+func asRevertError(err error) (*RevertError, bool) {
+	var dataErr eth.RPCDataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+
+	encoded, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+
+	data, decodeErr := hex.DecodeString(strings.TrimPrefix(encoded, "0x"))
+	if decodeErr != nil {
+		return nil, false
+	}
+
+	reason, _ := eth.UnpackRevert(data)
+	return &RevertError{Reason: reason, Data: data}, true
+}
+
+// isMethodNotFound returns whether the error indicates that the node does not support the JSON-RPC method.
+//
+// @param err Error returned by a JSON-RPC call
+// @return true if the method does not exist or is not available, false otherwise
+func isMethodNotFound(err error) bool {
+	var rpcErr eth.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == errCodeMethodNotFound
+}
+
+// isTerminalError returns whether the error indicates that the node rejected the request itself, so retrying the
+// request fails again, as opposed to a transient error such as a dropped connection or a temporary server error.
+//
+// @param err Error returned by a JSON-RPC call
+// @return true if the request is invalid or not supported by the node, false otherwise
+func isTerminalError(err error) bool {
+	var rpcErr eth.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	switch rpcErr.ErrorCode() {
+	case errCodeInvalidRequest, errCodeMethodNotFound, errCodeInvalidParams:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNonceTooLow returns whether the error indicates that the nonce of a transaction was too low.
+//
+// @param err Error returned when sending a transaction
+// @return true if the nonce was too low, false otherwise
+func isNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), errNonceTooLow)
+}
+
+// isOutOfGas returns whether a transaction failed because its gas limit was too low, either because it was rejected
+// by the node, or because it was mined and used its whole gas limit without succeeding.
+//
+// @param receipt Receipt of the transaction, or nil if it was not mined
+// @param gas Gas limit of the transaction
+// @param err Error returned when sending the transaction
+// @return true if the transaction ran out of gas, false otherwise
+func isOutOfGas(receipt *common.Receipt, gas uint64, err error) bool {
+	if receipt != nil && !receipt.Succeeded() && receipt.GasUsed >= gas {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, errOutOfGas) || strings.Contains(message, errIntrinsicGasTooLow)
+}
+
+// isReplacementUnderpriced returns whether the error indicates that a replacement transaction was underpriced.
+//
+// @param err Error returned when sending a transaction
+// @return true if the replacement transaction was underpriced, false otherwise
+func isReplacementUnderpriced(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), errReplacementUnderpriced)
+}
@@ -0,0 +1,55 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// FeeHistory is the fee history of a range of blocks, which can be used to choose the fee cap and priority fee of
+// EIP-1559 dynamic fee transactions.
+type FeeHistory struct {
+	// OldestBlock is the number of the first block in the range
+	OldestBlock *big.Int
+
+	// BaseFees are the base fees per gas of each block in the range, followed by the base fee of the next block
+	BaseFees []*big.Int
+
+	// GasUsedRatios are the ratios of gas used to the gas limit of each block in the range
+	GasUsedRatios []float64
+
+	// Rewards are the priority fees per gas at the requested percentiles of each block in the range, or nil if no
+	// percentiles were requested
+	Rewards [][]*big.Int
+}
+
+// FeeHistory returns the fee history of a range of blocks ending at the given block, using eth_feeHistory. The node
+// may return fewer blocks than requested, e.g. if the range starts before the first block it keeps history for.
+//
+// @param ctx Context for the request
+// @param blockCount Number of blocks in the range
+// @param lastBlock Number of the last block in the range, or nil for the latest block
+// @param rewardPercentiles Increasing percentiles (0 to 100) of the priority fees to return for each block, or nil
+// @return The fee history and nil error on success
+// @return nil and error if the fee history cannot be retrieved
+func (c *Client) FeeHistory(
+	ctx context.Context,
+	blockCount uint64,
+	lastBlock *big.Int,
+	rewardPercentiles []float64,
+) (*FeeHistory, error) {
+	history, err := c.ethClient.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get fee history: %w", err)
+	}
+
+	return &FeeHistory{
+		OldestBlock:   history.OldestBlock,
+		BaseFees:      history.BaseFee,
+		GasUsedRatios: history.GasUsedRatio,
+		Rewards:       history.Reward,
+	}, nil
+}
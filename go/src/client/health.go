@@ -0,0 +1,71 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// HealthStatus is the result of a Client health check.
+type HealthStatus struct {
+	// Reachable reports whether the node responded to a JSON-RPC request
+	Reachable bool
+
+	// Healthy reports whether the node is reachable and returned its latest block, so it is ready to serve requests
+	Healthy bool
+
+	// ChainID is the chain ID reported by the node, or nil if the node is not reachable
+	ChainID *big.Int
+
+	// LatestBlock is the latest block number reported by the node, or 0 if it could not be retrieved
+	LatestBlock uint64
+
+	// PeerCount is the number of peers reported by the node, or nil if the node does not report its peers
+	PeerCount *uint64
+
+	// Latency is the total time taken by the health check requests
+	Latency time.Duration
+}
+
+// Health checks that the node is reachable and responsive, e.g. for readiness probes when a service starts. The
+// chain ID and latest block number are required, and the peer count is included if the node reports it. A partial
+// HealthStatus is returned along with the error when a required check fails, so a reachable node that cannot return
+// its latest block is reported as degraded rather than unreachable.
+//
+// @param ctx Context for the request, which can be used to limit the time spent checking
+// @return The health status and nil error if the node is healthy
+// @return The partial health status and error if the node is unreachable or degraded
+func (c *Client) Health(ctx context.Context) (status HealthStatus, err error) {
+	start := time.Now()
+	defer func() {
+		status.Latency = time.Since(start)
+	}()
+
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return status, fmt.Errorf("node is unreachable: %w", err)
+	}
+	status.Reachable = true
+	status.ChainID = chainID
+
+	latest, err := c.BlockNumber(ctx)
+	if err != nil {
+		return status, fmt.Errorf("node is degraded: %w", err)
+	}
+	status.LatestBlock = latest
+
+	var peers eth.HexUint64
+	if err = c.ethClient.Client().CallContext(ctx, &peers, "net_peerCount"); err == nil {
+		count := uint64(peers)
+		status.PeerCount = &count
+	}
+
+	status.Healthy = true
+	return status, nil
+}
@@ -0,0 +1,141 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// blockScanChunkSize is the number of blocks requested in each batch when scanning blocks for transactions
+const blockScanChunkSize = 100
+
+// TransactionsByAccount returns the transactions sent from or to the given address in a range of blocks, in the order
+// they were mined. If a TxIndexer is set with WithTxIndexer, the indexer is used. Otherwise, JSON-RPC doesn't index
+// transactions by account, so every block in the range is fetched with its transactions, in batches of
+// blockScanChunkSize blocks. This takes a request per block, so scanning is only practical for short ranges, and an
+// indexing service should be used for full account histories.
+//
+// @param ctx Context for the requests
+// @param address Address of the account
+// @param fromBlock Number of the first block in the range, or nil for the genesis block
+// @param toBlock Number of the last block in the range, or nil for the latest block
+// @return The transactions of the account and nil error on success
+// @return nil and error if a block cannot be retrieved, or a transaction cannot be decoded
+func (c *Client) TransactionsByAccount(
+	ctx context.Context,
+	address common.Address,
+	fromBlock *big.Int,
+	toBlock *big.Int,
+) ([]*common.SignedTransaction, error) {
+	if c.txIndexer != nil {
+		return c.txIndexer.TransactionsByAccount(ctx, address, fromBlock, toBlock)
+	}
+
+	from := uint64(0)
+	if fromBlock != nil {
+		from = fromBlock.Uint64()
+	}
+
+	var to uint64
+	if toBlock != nil {
+		to = toBlock.Uint64()
+	} else {
+		latest, err := c.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		to = latest
+	}
+
+	var result []*common.SignedTransaction
+	for start := from; start <= to; start += blockScanChunkSize {
+		end := min(start+blockScanChunkSize-1, to)
+		txs, err := c.scanBlocks(ctx, address, start, end)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, txs...)
+
+		if end == to {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// scanBlocks fetches the blocks in the given range with a single batch of requests, and returns the transactions sent
+// from or to the given address. Transactions of unsupported types, which can't belong to an account using this SDK,
+// are skipped instead of failing the scan.
+//
+// @param ctx Context for the request
+// @param address Address of the account
+// @param from Number of the first block
+// @param to Number of the last block
+// @return The transactions of the account and nil error on success
+// @return nil and error if a block cannot be retrieved, or a transaction cannot be decoded
+func (c *Client) scanBlocks(ctx context.Context, address common.Address, from, to uint64) ([]*common.SignedTransaction, error) {
+	type block struct {
+		// Transactions are decoded one at a time, so a transaction of an unsupported type only skips that transaction
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+
+	blocks := make([]block, to-from+1)
+	batch := make([]eth.BatchElem, len(blocks))
+	for i := range batch {
+		batch[i] = eth.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{fmt.Sprintf("0x%x", from+uint64(i)), true},
+			Result: &blocks[i],
+		}
+	}
+
+	if err := c.ethClient.Client().BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("failed to get blocks: %w", err)
+	}
+
+	var result []*common.SignedTransaction
+	for i, elem := range batch {
+		if elem.Error != nil {
+			return nil, fmt.Errorf("failed to get block %d: %w", from+uint64(i), elem.Error)
+		}
+
+		for _, encoded := range blocks[i].Transactions {
+			tx := new(eth.Transaction)
+			if err := tx.UnmarshalJSON(encoded); err != nil {
+				if errors.Is(err, eth.ErrTxTypeNotSupported) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to decode transaction in block %d: %w", from+uint64(i), err)
+			}
+
+			sender, err := eth.Sender(eth.LatestSignerForChainID(tx.ChainId()), tx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get sender of transaction %s: %w", tx.Hash(), err)
+			}
+			if sender != address.EthAddress() && (tx.To() == nil || *tx.To() != address.EthAddress()) {
+				continue
+			}
+
+			raw, err := tx.MarshalBinary()
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode transaction %s: %w", tx.Hash(), err)
+			}
+			signed, err := common.DecodeSignedTransaction(raw)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode transaction %s: %w", tx.Hash(), err)
+			}
+			result = append(result, signed)
+		}
+	}
+
+	return result, nil
+}
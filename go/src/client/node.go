@@ -0,0 +1,152 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ClientVersion is the version of the node software, parsed from the web3_clientVersion string, which is conventionally
+// formatted as "<name>/<version>/<os>/<runtime>" (e.g. "Geth/v1.15.2-stable/linux-amd64/go1.23.6").
+type ClientVersion struct {
+	// Raw is the version string as reported by the node
+	Raw string
+
+	// Name is the name of the node software (e.g. "Geth")
+	Name string
+
+	// Version is the version of the node software (e.g. "v1.15.2-stable"), or empty if not reported
+	Version string
+
+	// OS is the operating system and architecture the node runs on (e.g. "linux-amd64"), or empty if not reported
+	OS string
+
+	// Runtime is the language runtime the node was built with (e.g. "go1.23.6"), or empty if not reported
+	Runtime string
+}
+
+// NetworkInfo describes the network a Client is connected to, as returned by NetworkInfo.
+type NetworkInfo struct {
+	// ChainID is the chain ID of the network, which transactions are signed for
+	ChainID *big.Int
+
+	// NetworkID is the network ID reported by net_version, which usually equals the chain ID
+	NetworkID *big.Int
+
+	// ClientVersion is the version of the node software
+	ClientVersion ClientVersion
+}
+
+// SyncProgress is the progress of a node that is syncing the chain.
+type SyncProgress struct {
+	// StartingBlock is the block number the sync started at
+	StartingBlock uint64
+
+	// CurrentBlock is the block number the node has synced to
+	CurrentBlock uint64
+
+	// HighestBlock is the highest known block number of the chain
+	HighestBlock uint64
+}
+
+// RemainingBlocks returns the number of blocks left to sync.
+//
+// @return The number of blocks between the current and highest block, or 0 if the node has caught up
+func (p *SyncProgress) RemainingBlocks() uint64 {
+	if p.CurrentBlock >= p.HighestBlock {
+		return 0
+	}
+	return p.HighestBlock - p.CurrentBlock
+}
+
+// ClientVersion returns the version of the node software, using web3_clientVersion.
+//
+// @param ctx Context for the request
+// @return The parsed client version and nil error on success
+// @return Empty ClientVersion and error if the version cannot be retrieved
+func (c *Client) ClientVersion(ctx context.Context) (ClientVersion, error) {
+	var raw string
+	if err := c.ethClient.Client().CallContext(ctx, &raw, "web3_clientVersion"); err != nil {
+		return ClientVersion{}, fmt.Errorf("failed to get client version: %w", err)
+	}
+
+	version := ClientVersion{Raw: raw}
+	parts := strings.SplitN(raw, "/", 4)
+	for i, field := range []*string{&version.Name, &version.Version, &version.OS, &version.Runtime} {
+		if i < len(parts) {
+			*field = parts[i]
+		}
+	}
+
+	return version, nil
+}
+
+// NetVersion returns the network ID of the node, using net_version. The network ID usually equals the chain ID, but
+// may differ on some networks.
+//
+// @param ctx Context for the request
+// @return The network ID and nil error on success
+// @return nil and error if the network ID cannot be retrieved or parsed
+func (c *Client) NetVersion(ctx context.Context) (*big.Int, error) {
+	networkID, err := c.ethClient.NetworkID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network ID: %w", err)
+	}
+
+	return networkID, nil
+}
+
+// NetworkInfo returns the chain ID, network ID, and node software version of the network the Client is connected to, in
+// one call, e.g. to show what a UI is connected to, or to check that a service is connected to the expected network.
+//
+// @param ctx Context for the requests
+// @return The network info and nil error on success
+// @return nil and error if any of the network info cannot be retrieved
+func (c *Client) NetworkInfo(ctx context.Context) (*NetworkInfo, error) {
+	chainID, err := c.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	networkID, err := c.NetVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := c.ClientVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInfo{
+		ChainID:       chainID,
+		NetworkID:     networkID,
+		ClientVersion: version,
+	}, nil
+}
+
+// SyncProgress returns the sync progress of the node, using eth_syncing.
+//
+// @param ctx Context for the request
+// @return The sync progress and nil error if the node is syncing
+// @return nil and nil error if the node is not syncing
+// @return nil and error if the sync progress cannot be retrieved
+func (c *Client) SyncProgress(ctx context.Context) (*SyncProgress, error) {
+	progress, err := c.ethClient.SyncProgress(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sync progress: %w", err)
+	}
+	if progress == nil {
+		return nil, nil
+	}
+
+	return &SyncProgress{
+		StartingBlock: progress.StartingBlock,
+		CurrentBlock:  progress.CurrentBlock,
+		HighestBlock:  progress.HighestBlock,
+	}, nil
+}
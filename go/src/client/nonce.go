@@ -0,0 +1,132 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// NonceManager tracks the next nonce of each sender address locally, so that multiple transactions from the same
+// sender can be submitted concurrently without waiting for each one to be mined. The nonce of an address is fetched
+// from the node the first time it is used, and again whenever the node reports that the tracked nonce is too low.
+// A NonceManager is safe for concurrent use, and is enabled on a Client with the WithNonceManager option.
+type NonceManager struct {
+	// mu guards nonces
+	mu sync.Mutex
+
+	// nonces maps the lowercase hex address of each sender to its next nonce
+	nonces map[string]uint64
+}
+
+// NewNonceManager creates a new NonceManager with no tracked nonces.
+//
+// @return A new NonceManager instance
+func NewNonceManager() *NonceManager {
+	return &NonceManager{nonces: make(map[string]uint64)}
+}
+
+// Reset discards the tracked nonce of the given address, so the next nonce is fetched from the node.
+//
+// @param address Address of the sender to reset
+func (m *NonceManager) Reset(address common.Address) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.nonces, nonceKey(address))
+}
+
+// next reserves and returns the next nonce of the given address, fetching the pending nonce from the node if the
+// address is not yet tracked.
+//
+// @param ctx Context for the request
+// @param fetch Function that fetches the pending nonce of the address from the node
+// @param address Address of the sender
+// @return The reserved nonce and nil error on success
+// @return 0 and error if the nonce cannot be fetched
+func (m *NonceManager) next(
+	ctx context.Context,
+	fetch func(context.Context, common.Address) (uint64, error),
+	address common.Address,
+) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := nonceKey(address)
+	nonce, ok := m.nonces[key]
+	if !ok {
+		var err error
+		if nonce, err = fetch(ctx, address); err != nil {
+			return 0, err
+		}
+	}
+
+	m.nonces[key] = nonce + 1
+	return nonce, nil
+}
+
+// peek returns the next nonce of the given address without reserving it, fetching the pending nonce from the node if
+// the address is not yet tracked.
+//
+// @param ctx Context for the request
+// @param fetch Function that fetches the pending nonce of the address from the node
+// @param address Address of the sender
+// @return The next nonce and nil error on success
+// @return 0 and error if the nonce cannot be fetched
+func (m *NonceManager) peek(
+	ctx context.Context,
+	fetch func(context.Context, common.Address) (uint64, error),
+	address common.Address,
+) (uint64, error) {
+	m.mu.Lock()
+	nonce, ok := m.nonces[nonceKey(address)]
+	m.mu.Unlock()
+	if ok {
+		return nonce, nil
+	}
+
+	return fetch(ctx, address)
+}
+
+// release returns a reserved nonce of the given address that was not used. If it is the most recently reserved nonce
+// of the address, it is reserved again next. Otherwise, later nonces have been reserved since, so the tracked nonce is
+// discarded and fetched from the node again, instead of leaving a gap.
+//
+// @param address Address of the sender
+// @param nonce The unused nonce
+func (m *NonceManager) release(address common.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := nonceKey(address)
+	if next, ok := m.nonces[key]; ok && next == nonce+1 {
+		m.nonces[key] = nonce
+		return
+	}
+	delete(m.nonces, key)
+}
+
+// resync discards the tracked nonce of the given address, then reserves and returns the pending nonce fetched from
+// the node.
+//
+// @param ctx Context for the request
+// @param fetch Function that fetches the pending nonce of the address from the node
+// @param address Address of the sender
+// @return The reserved nonce and nil error on success
+// @return 0 and error if the nonce cannot be fetched
+func (m *NonceManager) resync(
+	ctx context.Context,
+	fetch func(context.Context, common.Address) (uint64, error),
+	address common.Address,
+) (uint64, error) {
+	m.Reset(address)
+	return m.next(ctx, fetch, address)
+}
+
+// nonceKey returns the key used to track the nonce of the given address.
+func nonceKey(address common.Address) string {
+	return strings.ToLower(address.Hex())
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/radiustechsystems/sdk/go/src/transport"
 )
@@ -13,14 +14,132 @@ type Option func(*Options)
 // Options contains configuration options for a new Radius Client.
 // These options control how the client connects to and interacts with the Radius node.
 type Options struct {
+	// cache stores immutable chain data, if set
+	cache Cache
+
+	// deployValidation enables checking deployed contract code against the contract ABI
+	deployValidation bool
+
+	// estimateTransfers enables gas estimation for plain value transfers
+	estimateTransfers bool
+
+	// gasMarginPercent is the percentage added to gas estimates as a safety margin
+	gasMarginPercent int
+
+	// gasObserver is called with the gas limit and gas used of each mined transaction, if set
+	gasObserver GasObserver
+
 	// httpClient is the HTTP client used for making API requests
 	httpClient *http.Client
 
 	// interceptor is a function for modifying or monitoring JSON-RPC responses
 	interceptor transport.Interceptor
 
+	// logLevel controls which requests and responses are logged by the logger
+	logLevel transport.LogLevel
+
 	// logger is a function for debugging request/response cycles
 	logger transport.Logf
+
+	// maxBodyLog is the maximum number of bytes of each response body that are logged
+	maxBodyLog int
+
+	// maxGas is the maximum gas limit of estimated transactions
+	maxGas uint64
+
+	// nativeDecimals is the number of decimals of the native currency
+	nativeDecimals int
+
+	// nonceManager tracks sender nonces locally, if set
+	nonceManager *NonceManager
+
+	// pollInterval is the interval between polls for new logs when watching events over HTTP
+	pollInterval time.Duration
+
+	// replaceBumpPercent is the percentage to bump the gas price of underpriced replacement transactions by
+	replaceBumpPercent int
+
+	// requestID is a function for generating the IDs of outgoing JSON-RPC requests
+	requestID transport.RequestIDFunc
+
+	// requestInterceptor is a function for modifying or monitoring JSON-RPC requests before they are sent
+	requestInterceptor transport.RequestInterceptor
+
+	// resolver is used to resolve names to addresses
+	resolver Resolver
+
+	// transport is the HTTP transport used to send requests, if set
+	transport *http.Transport
+
+	// txIndexer is used to look up the transactions of an account, if set
+	txIndexer TxIndexer
+
+	// userAgent is the User-Agent header sent with each request, if set
+	userAgent string
+}
+
+// WithAutoReplace creates an option to automatically replace underpriced transactions.
+// When a node rejects a transaction with "replacement transaction underpriced", the transaction is re-signed with the
+// same nonce and a gas price bumped by the given percentage, and sent again, up to a limited number of attempts. The fee
+// cap and tip cap of dynamic fee transactions are bumped instead of the gas price.
+//
+// @param bumpPercent Percentage to bump the gas price, or fee cap and tip cap, by on each attempt (e.g. 10 for 10%)
+// @return An Option function that can be passed to New()
+func WithAutoReplace(bumpPercent int) Option {
+	return func(o *Options) {
+		o.replaceBumpPercent = bumpPercent
+	}
+}
+
+// WithCache creates an option to cache immutable chain data with the given Cache. The chain ID and deployed contract
+// code are then requested from the node only once, which cuts redundant requests in read-heavy services. Code is only
+// cached once it is deployed, since an address without code may have a contract deployed to it later.
+//
+// @param cache Cache used to store immutable chain data, which may be shared between Clients of the same network
+// @return An Option function that can be passed to New()
+func WithCache(cache Cache) Option {
+	return func(o *Options) {
+		o.cache = cache
+	}
+}
+
+// WithDeployValidation creates an option to check that contracts deployed with DeployContract match their ABI. After
+// each deployment, the runtime code of the contract is fetched and the selector of each ABI method is looked up in the
+// dispatch table of the code, and a warning is logged with the logger set with WithLogger if any are missing. This is a
+// heuristic that catches an ABI paired with the wrong bytecode, so a mismatch never fails the deployment.
+//
+// @return An Option function that can be passed to New()
+func WithDeployValidation() Option {
+	return func(o *Options) {
+		o.deployValidation = true
+	}
+}
+
+// WithGasMargin creates an option to set the safety margin added to gas estimates, as a percentage of the estimate.
+// The margin applies wherever the client estimates gas, unless a transaction skips it with TxOptions.SkipGasMargin.
+// The default margin is 20%.
+//
+// @param percent Percentage of the gas estimate to add as a safety margin (e.g. 20 for 20%), or 0 for no margin
+// @return An Option function that can be passed to New()
+func WithGasMargin(percent int) Option {
+	return func(o *Options) {
+		o.gasMarginPercent = percent
+	}
+}
+
+// WithGasObserver creates an option to observe the gas used by each transaction sent by the Client against its gas
+// limit, which is the gas estimate with the safety margin, unless the gas limit was set explicitly or taken from a gas
+// preset, or the transaction is a plain transfer with the TransferGas limit. The observer is called once the receipt of
+// each mined transaction is available, including failed transactions, e.g. to log transactions that ran out of gas or
+// whose gas usage diverges from the limit, and right-size the gas margin. The observer is called synchronously, so it
+// should return quickly.
+//
+// @param observer Function called with the method name, gas limit, and gas used of each mined transaction
+// @return An Option function that can be passed to New()
+func WithGasObserver(observer GasObserver) Option {
+	return func(o *Options) {
+		o.gasObserver = observer
+	}
 }
 
 // WithHTTPClient creates an option to set a custom HTTP client for the Radius Client.
@@ -46,6 +165,19 @@ func WithInterceptor(interceptor transport.Interceptor) Option {
 	}
 }
 
+// WithLogLevel creates an option to control which requests and responses are logged by the logger set with WithLogger.
+// By default, every request and response is logged in full (LogAll), which suits development. With LogErrorsOnly, only
+// failed requests are logged, with the JSON-RPC methods, HTTP status, and error, but without request bodies, which may
+// contain sensitive data such as signed transactions, so it suits production.
+//
+// @param level The log level, LogAll or LogErrorsOnly
+// @return An Option function that can be passed to New()
+func WithLogLevel(level transport.LogLevel) Option {
+	return func(o *Options) {
+		o.logLevel = level
+	}
+}
+
 // WithLogger creates an option to set a logger for the Radius Client.
 // This can be used to log JSON-RPC requests and responses for debugging or audit purposes.
 // The logger receives the raw request and response bodies for inspection.
@@ -57,3 +189,146 @@ func WithLogger(logger transport.Logf) Option {
 		o.logger = logger
 	}
 }
+
+// WithMaxBodyLog creates an option to limit how much of each response body is logged by the logger set with
+// WithLogger. Only the logged bytes are buffered, and the rest of the body is streamed, so large responses (e.g.
+// eth_getLogs results) are not read into memory for logging. By default, the whole body is logged.
+//
+// @param maxBytes Maximum number of bytes of each response body to log, or 0 to log the whole body
+// @return An Option function that can be passed to New()
+func WithMaxBodyLog(maxBytes int) Option {
+	return func(o *Options) {
+		o.maxBodyLog = maxBytes
+	}
+}
+
+// WithMaxGas creates an option to cap the gas limit of estimated transactions, after the safety margin is applied.
+// By default, gas limits are capped at MaxGas.
+//
+// @param maxGas Maximum gas limit of estimated transactions, or 0 for the default
+// @return An Option function that can be passed to New()
+func WithMaxGas(maxGas uint64) Option {
+	return func(o *Options) {
+		o.maxGas = maxGas
+	}
+}
+
+// WithNativeDecimals creates an option to set the number of decimals of the native currency, which is used to format
+// amounts with FormatNative and BalanceEtherAt. The default is 18 decimals, as for ether. The client cannot be created
+// with negative decimals.
+//
+// @param decimals Number of decimals of the native currency
+// @return An Option function that can be passed to New()
+func WithNativeDecimals(decimals int) Option {
+	return func(o *Options) {
+		o.nativeDecimals = decimals
+	}
+}
+
+// WithNonceManager creates an option to track sender nonces locally with the given NonceManager.
+// Instead of fetching the pending nonce from the node for every transaction, the next nonce of each sender is reserved
+// from the NonceManager, so concurrent transactions from the same sender receive distinct nonces. If the node rejects
+// a transaction with "nonce too low", the nonce is resynced from the node and the transaction is resent once. Nonces
+// set explicitly with TxOptions or ExecuteOptions are never resynced, so that the transaction is not resent as a new
+// transaction instead of replacing a pending one.
+//
+// @param manager NonceManager used to track sender nonces, which may be shared between Clients
+// @return An Option function that can be passed to New()
+func WithNonceManager(manager *NonceManager) Option {
+	return func(o *Options) {
+		o.nonceManager = manager
+	}
+}
+
+// WithPollInterval creates an option to set the interval between polls for new logs when watching events over HTTP,
+// which doesn't support subscriptions, and between polls of the nonce in WaitForNonce and of the receipt in
+// WaitForReceipt. The default interval is one second.
+//
+// @param interval Interval between polls for new logs
+// @return An Option function that can be passed to New()
+func WithPollInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.pollInterval = interval
+	}
+}
+
+// WithRequestIDFunc creates an option to generate the IDs of outgoing JSON-RPC requests with the given function.
+// By default, request IDs are sequential numbers, so this can be used to send traceable IDs (e.g. UUIDs) that can be
+// correlated across the logs of the client, proxies, and the Radius server. Responses are matched to requests as usual.
+//
+// @param generate Function that returns a new, unique ID for each request
+// @return An Option function that can be passed to New()
+func WithRequestIDFunc(generate transport.RequestIDFunc) Option {
+	return func(o *Options) {
+		o.requestID = generate
+	}
+}
+
+// WithRequestInterceptor creates an option to set a request interceptor for the Radius Client.
+// This can be used to log, modify, or validate requests before they are sent to the Radius server, complementing the
+// response interceptor set with WithInterceptor. If the interceptor returns an error, the request is not sent.
+//
+// @param interceptor Function that can intercept and potentially modify outgoing JSON-RPC requests
+// @return An Option function that can be passed to New()
+func WithRequestInterceptor(interceptor transport.RequestInterceptor) Option {
+	return func(o *Options) {
+		o.requestInterceptor = interceptor
+	}
+}
+
+// WithResolver creates an option to set a name Resolver for the Radius Client.
+// The resolver is used by methods that accept a name instead of an address, such as SendToName.
+//
+// @param resolver Resolver used to resolve names to addresses
+// @return An Option function that can be passed to New()
+func WithResolver(resolver Resolver) Option {
+	return func(o *Options) {
+		o.resolver = resolver
+	}
+}
+
+// WithTransferGasEstimation creates an option to estimate the gas of plain value transfers. By default, transactions
+// with no data and no access list are sent with the fixed TransferGas limit, without an eth_estimateGas request, since
+// a transfer to an account without code always costs the same. Transfers to contracts with a receive or fallback
+// function cost more and run out of gas with that limit, so enable estimation if value is sent to contracts.
+//
+// @return An Option function that can be passed to New()
+func WithTransferGasEstimation() Option {
+	return func(o *Options) {
+		o.estimateTransfers = true
+	}
+}
+
+// WithTransport creates an option to set the HTTP transport used to send requests, e.g. to tune connection pooling
+// with MaxIdleConnsPerHost, or to set timeouts, without building a custom HTTP client. The transport replaces the
+// transport of the HTTP client, and is wrapped by the logger and interceptors, if set.
+//
+// @param transport HTTP transport used to send requests
+// @return An Option function that can be passed to New()
+func WithTransport(transport *http.Transport) Option {
+	return func(o *Options) {
+		o.transport = transport
+	}
+}
+
+// WithTxIndexer creates an option to look up the transactions of an account with the given TxIndexer, e.g. backed by
+// an indexing service, instead of scanning blocks in Client.TransactionsByAccount.
+//
+// @param indexer TxIndexer used to look up the transactions of an account
+// @return An Option function that can be passed to New()
+func WithTxIndexer(indexer TxIndexer) Option {
+	return func(o *Options) {
+		o.txIndexer = indexer
+	}
+}
+
+// WithUserAgent creates an option to send the given User-Agent header with each request, e.g. to identify the
+// application to an RPC provider that rate-limits by User-Agent.
+//
+// @param userAgent User-Agent header to send with each request
+// @return An Option function that can be passed to New()
+func WithUserAgent(userAgent string) Option {
+	return func(o *Options) {
+		o.userAgent = userAgent
+	}
+}
@@ -0,0 +1,156 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// CallMsg contains the parameters of a call simulated with CallWithOverrides.
+type CallMsg struct {
+	// From is the address the call is sent from, or the zero address
+	From common.Address
+
+	// To is the address of the called contract, or nil to simulate a contract creation
+	To *common.Address
+
+	// Data is the calldata of the call
+	Data []byte
+
+	// Value is the amount of native currency sent with the call in wei, or nil to send none
+	Value *big.Int
+
+	// Gas is the gas limit of the call, or 0 for the node default
+	Gas uint64
+}
+
+// OverrideAccount replaces parts of the state of an account for the duration of a call simulated with
+// CallWithOverrides. Unset fields keep the state of the account.
+type OverrideAccount struct {
+	// Balance replaces the balance of the account in wei, if set
+	Balance *big.Int
+
+	// Code replaces the code of the account, if set, so an empty slice clears the code
+	Code []byte
+
+	// Nonce replaces the nonce of the account, if set
+	Nonce *uint64
+
+	// State replaces the whole storage of the account with the given slots, if set, so an empty slice clears the storage
+	State []StorageSlot
+
+	// StateDiff replaces the given storage slots of the account, keeping the other slots, if set
+	StateDiff []StorageSlot
+}
+
+// StateOverride maps the addresses of accounts to the overrides of their state in CallWithOverrides.
+type StateOverride map[common.Address]OverrideAccount
+
+// StorageSlot is the value of a storage slot, used to override the storage of an account.
+type StorageSlot struct {
+	// Slot is the storage slot
+	Slot common.Hash
+
+	// Value is the value of the storage slot
+	Value common.Hash
+}
+
+// overrideAccountArg is the JSON-RPC representation of an OverrideAccount
+type overrideAccountArg struct {
+	Balance   *eth.HexBig            `json:"balance,omitempty"`
+	Code      *eth.HexBytes          `json:"code,omitempty"`
+	Nonce     *eth.HexUint64         `json:"nonce,omitempty"`
+	State     *map[eth.Hash]eth.Hash `json:"state,omitempty"`
+	StateDiff *map[eth.Hash]eth.Hash `json:"stateDiff,omitempty"`
+}
+
+// CallWithOverrides simulates a call with eth_call as if the state of some accounts were different, e.g. as if an
+// account had a certain balance, or a contract had different code or storage. The overrides only apply to the call,
+// and are not supported by every node.
+//
+// @param ctx Context for the request
+// @param msg The parameters of the call
+// @param blockNumber Block number to simulate the call at, or nil for the latest block
+// @param overrides The state overrides, keyed by account address
+// @return Raw result of the call and nil error on success
+// @return nil and *RevertError with the decoded revert reason if the call reverts
+// @return nil and error if the call fails
+func (c *Client) CallWithOverrides(
+	ctx context.Context,
+	msg CallMsg,
+	blockNumber *big.Int,
+	overrides StateOverride,
+) ([]byte, error) {
+	args := map[string]interface{}{
+		"from": msg.From.EthAddress(),
+		"data": eth.HexBytes(msg.Data),
+	}
+	if msg.To != nil {
+		args["to"] = msg.To.EthAddress()
+	}
+	if msg.Value != nil {
+		args["value"] = (*eth.HexBig)(msg.Value)
+	}
+	if msg.Gas != 0 {
+		args["gas"] = eth.HexUint64(msg.Gas)
+	}
+
+	block := common.BlockTagLatest
+	if blockNumber != nil {
+		block = fmt.Sprintf("0x%x", blockNumber)
+	}
+
+	var result eth.HexBytes
+	err := c.ethClient.Client().CallContext(ctx, &result, "eth_call", args, block, overrideArgs(overrides))
+	if revertErr, ok := asRevertError(err); ok {
+		return nil, fmt.Errorf("call failed: %w", revertErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("call failed: %w", err)
+	}
+
+	return result, nil
+}
+
+// overrideArgs returns the JSON-RPC representation of the state overrides.
+func overrideArgs(overrides StateOverride) map[eth.Address]overrideAccountArg {
+	args := make(map[eth.Address]overrideAccountArg, len(overrides))
+	for address, override := range overrides {
+		arg := overrideAccountArg{
+			State:     storageArgs(override.State),
+			StateDiff: storageArgs(override.StateDiff),
+		}
+		if override.Balance != nil {
+			arg.Balance = (*eth.HexBig)(override.Balance)
+		}
+		if override.Code != nil {
+			code := eth.HexBytes(override.Code)
+			arg.Code = &code
+		}
+		if override.Nonce != nil {
+			nonce := eth.HexUint64(*override.Nonce)
+			arg.Nonce = &nonce
+		}
+		args[address.EthAddress()] = arg
+	}
+	return args
+}
+
+// storageArgs returns the JSON-RPC representation of storage slot overrides, or nil if they are not set.
+func storageArgs(slots []StorageSlot) *map[eth.Hash]eth.Hash {
+	if slots == nil {
+		return nil
+	}
+
+	args := make(map[eth.Hash]eth.Hash, len(slots))
+	for _, slot := range slots {
+		args[eth.BytesToHash(slot.Slot.Bytes())] = eth.BytesToHash(slot.Value.Bytes())
+	}
+	return &args
+}
@@ -0,0 +1,146 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// AccountProof is the Merkle proof of the state of an account, and of some of its storage slots, returned by GetProof.
+// It can be verified against the state root of the block it was requested for, e.g. by a light client or a
+// cross-chain bridge.
+type AccountProof struct {
+	// Address is the address of the account
+	Address common.Address
+
+	// AccountProof is the RLP-encoded nodes of the state trie, from the state root to the account
+	AccountProof [][]byte
+
+	// Balance is the balance of the account in wei
+	Balance *big.Int
+
+	// CodeHash is the Keccak-256 hash of the code of the account
+	CodeHash common.Hash
+
+	// Nonce is the nonce of the account
+	Nonce uint64
+
+	// StorageHash is the root of the storage trie of the account
+	StorageHash common.Hash
+
+	// StorageProof is the proof of each requested storage slot, in the order of the storage keys
+	StorageProof []StorageProof
+}
+
+// StorageProof is the Merkle proof of the value of a storage slot of an account.
+type StorageProof struct {
+	// Key is the storage slot
+	Key common.Hash
+
+	// Value is the value of the storage slot
+	Value *big.Int
+
+	// Proof is the RLP-encoded nodes of the storage trie, from the storage root to the slot
+	Proof [][]byte
+}
+
+// accountProofResult is the JSON-RPC result of eth_getProof
+type accountProofResult struct {
+	Address      eth.Address     `json:"address"`
+	AccountProof []eth.HexBytes  `json:"accountProof"`
+	Balance      *eth.HexBig     `json:"balance"`
+	CodeHash     eth.Hash        `json:"codeHash"`
+	Nonce        eth.HexUint64   `json:"nonce"`
+	StorageHash  eth.Hash        `json:"storageHash"`
+	StorageProof []storageResult `json:"storageProof"`
+}
+
+// storageResult is the JSON-RPC result of the proof of a storage slot in eth_getProof
+type storageResult struct {
+	Key   string         `json:"key"`
+	Value *eth.HexBig    `json:"value"`
+	Proof []eth.HexBytes `json:"proof"`
+}
+
+// GetProof returns the Merkle proof of the state of an account and of the given storage slots, using eth_getProof.
+//
+// @param ctx Context for the request
+// @param address Address of the account
+// @param storageKeys Storage slots of the account to prove, if any
+// @param blockNumber Block number to prove the state at, or nil for the latest block
+// @return The account proof and nil error on success
+// @return nil and ErrMethodUnsupported if the node does not support eth_getProof
+// @return nil and error if the proof cannot be retrieved from the network
+func (c *Client) GetProof(
+	ctx context.Context,
+	address common.Address,
+	storageKeys []common.Hash,
+	blockNumber *big.Int,
+) (*AccountProof, error) {
+	keys := make([]string, len(storageKeys))
+	for i, key := range storageKeys {
+		keys[i] = eth.BytesToHash(key.Bytes()).Hex()
+	}
+
+	block := common.BlockTagLatest
+	if blockNumber != nil {
+		block = fmt.Sprintf("0x%x", blockNumber)
+	}
+
+	var result accountProofResult
+	if err := c.ethClient.Client().CallContext(ctx, &result, "eth_getProof", address.EthAddress(), keys, block); err != nil {
+		if isMethodNotFound(err) {
+			return nil, fmt.Errorf("failed to get proof: %w", ErrMethodUnsupported)
+		}
+		return nil, fmt.Errorf("failed to get proof: %w", err)
+	}
+
+	proof := &AccountProof{
+		Address:      common.NewAddress(result.Address.Bytes()),
+		AccountProof: proofNodes(result.AccountProof),
+		Balance:      hexBigToInt(result.Balance),
+		CodeHash:     common.NewHash(result.CodeHash.Bytes()),
+		Nonce:        uint64(result.Nonce),
+		StorageHash:  common.NewHash(result.StorageHash.Bytes()),
+		StorageProof: make([]StorageProof, len(result.StorageProof)),
+	}
+	for i, storage := range result.StorageProof {
+		// Nodes may return the key as a quantity without leading zeros, so it is padded to 32 bytes
+		key, ok := new(big.Int).SetString(strings.TrimPrefix(storage.Key, "0x"), 16)
+		if !ok {
+			return nil, fmt.Errorf("failed to get proof: invalid storage key %s", storage.Key)
+		}
+
+		proof.StorageProof[i] = StorageProof{
+			Key:   common.NewHash(eth.BytesToHash(key.Bytes()).Bytes()),
+			Value: hexBigToInt(storage.Value),
+			Proof: proofNodes(storage.Proof),
+		}
+	}
+
+	return proof, nil
+}
+
+// hexBigToInt returns the value of a hex big integer, or zero if it is missing
+func hexBigToInt(value *eth.HexBig) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return value.ToInt()
+}
+
+// proofNodes returns the bytes of the nodes of a Merkle proof
+func proofNodes(nodes []eth.HexBytes) [][]byte {
+	result := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		result[i] = node
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// implementationSlot is the EIP-1967 storage slot of a proxy's implementation address, which is
+// bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1)
+var implementationSlot, _ = common.HashFromHex("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+
+// ImplementationAddress returns the address of the implementation contract of an EIP-1967 proxy, read from the
+// standard implementation storage slot. The implementation ABI can then be used to interact with the proxy address.
+//
+// @param ctx Context for the request
+// @param proxy Address of the proxy contract
+// @return The implementation contract address and nil error on success
+// @return Zero address and error if the storage cannot be read, or the implementation slot is empty
+func (c *Client) ImplementationAddress(ctx context.Context, proxy common.Address) (common.Address, error) {
+	value, err := c.StorageAt(ctx, proxy, implementationSlot, nil)
+	if err != nil {
+		return common.ZeroAddress(), err
+	}
+	if len(value) != 32 || bytes.Equal(value, make([]byte, 32)) {
+		return common.ZeroAddress(), fmt.Errorf("no EIP-1967 implementation address set for %s", proxy.Hex())
+	}
+
+	return common.NewAddress(value[12:]), nil
+}
@@ -0,0 +1,282 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/auth"
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// defaultMaxInFlight is the default maximum number of transactions a TxQueue waits for at a time
+const defaultMaxInFlight = 16
+
+// TxQueueOptions contains the limits of a TxQueue. The zero value uses the default limits.
+type TxQueueOptions struct {
+	// MaxInFlight is the maximum number of sent transactions that may be waiting to be mined at a time. If zero, the
+	// default of 16 is used.
+	MaxInFlight int
+
+	// Interval is the minimum time between sending transactions. If zero, transactions are sent as fast as the
+	// MaxInFlight limit allows.
+	Interval time.Duration
+}
+
+// TxResult is the outcome of a transaction submitted to a TxQueue.
+type TxResult struct {
+	// Hash is the hash of the sent transaction, or empty if the transaction could not be sent
+	Hash common.Hash
+
+	// Receipt is the receipt of the mined transaction, or nil if Err is set
+	Receipt *common.Receipt
+
+	// Err is set if the transaction could not be sent, or failed
+	Err error
+}
+
+// TxQueue sends the transactions of a single signer in submission order, with consecutive nonces reserved from a
+// NonceManager, while limiting the number of transactions waiting to be mined and the rate at which they are sent.
+// This keeps bursts of transactions from overwhelming the node or exceeding mempool limits. Transactions are
+// submitted with Submit, and sent while Run is running. A TxQueue is safe for concurrent use.
+type TxQueue struct {
+	// client is the Radius Client used to send transactions and wait for receipts
+	client *Client
+
+	// inFlight holds a token for each sent transaction that is waiting to be mined
+	inFlight chan struct{}
+
+	// interval is the minimum time between sending transactions
+	interval time.Duration
+
+	// mu guards queued
+	mu sync.Mutex
+
+	// nonces reserves the nonces of the signer
+	nonces *NonceManager
+
+	// queued are the submitted transactions that have not been sent yet, in submission order
+	queued []queuedTx
+
+	// signer signs the transactions of the queue
+	signer auth.Signer
+
+	// wake is signaled when a transaction is submitted
+	wake chan struct{}
+}
+
+// queuedTx is a transaction submitted to a TxQueue.
+type queuedTx struct {
+	// data is the transaction data
+	data []byte
+
+	// result receives the outcome of the transaction
+	result chan TxResult
+
+	// to is the destination address, or nil for contract creation
+	to *common.Address
+
+	// value is the amount of native currency to send in wei
+	value *big.Int
+}
+
+// NewTxQueue creates a new TxQueue that sends transactions signed by the given signer with the given Client. Nonces
+// are reserved from the NonceManager of the Client if one is set with WithNonceManager, or else from a NonceManager
+// owned by the queue, so the signer should not send other transactions while the queue is in use.
+//
+// @param client The Radius Client used to send transactions and wait for receipts
+// @param signer The signer of the transactions
+// @param opts The limits of the queue
+// @return A new TxQueue instance
+func NewTxQueue(client *Client, signer auth.Signer, opts TxQueueOptions) *TxQueue {
+	maxInFlight := opts.MaxInFlight
+	if maxInFlight <= 0 {
+		maxInFlight = defaultMaxInFlight
+	}
+
+	nonces := client.nonceManager
+	if nonces == nil {
+		nonces = NewNonceManager()
+	}
+
+	return &TxQueue{
+		client:   client,
+		inFlight: make(chan struct{}, maxInFlight),
+		interval: opts.Interval,
+		nonces:   nonces,
+		signer:   signer,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// Len returns the number of submitted transactions that have not been sent yet.
+//
+// @return The number of queued transactions
+func (q *TxQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.queued)
+}
+
+// Submit queues a transaction to be sent by Run, and returns a channel that receives the outcome of the transaction
+// once it is mined, or fails. Submit never blocks, and transactions are sent in the order they are submitted.
+//
+// @param to Destination address, or nil for contract creation
+// @param value Amount of native currency to send in wei, or nil for zero
+// @param data Transaction data
+// @return A channel that receives a single TxResult, and is then closed
+func (q *TxQueue) Submit(to *common.Address, value *big.Int, data []byte) <-chan TxResult {
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	result := make(chan TxResult, 1)
+	q.mu.Lock()
+	q.queued = append(q.queued, queuedTx{data: data, result: result, to: to, value: value})
+	q.mu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	return result
+}
+
+// Run sends the submitted transactions in order, waiting for a free in-flight slot and the send interval before each
+// one, and delivers the outcome of each transaction once it is mined. Run blocks until the context is done, so it is
+// usually run in its own goroutine. Transactions that are still queued when the context is done remain queued until
+// Run is called again, while transactions that are waiting to be mined receive the context error.
+//
+// @param ctx Context for sending transactions and waiting for receipts, which stops the queue when done
+// @return The context error once the context is done
+func (q *TxQueue) Run(ctx context.Context) error {
+	var lastSent time.Time
+	for {
+		tx, ok := q.peek()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-q.wake:
+				continue
+			}
+		}
+
+		// Wait for a transaction to be mined if the in-flight limit is reached
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case q.inFlight <- struct{}{}:
+		}
+
+		// Wait for the send interval to pass since the last transaction was sent
+		if wait := time.Until(lastSent.Add(q.interval)); !lastSent.IsZero() && wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				<-q.inFlight
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+
+		q.pop()
+		hash, err := q.send(ctx, tx)
+		lastSent = time.Now()
+		if err != nil {
+			<-q.inFlight
+			tx.result <- TxResult{Err: err}
+			close(tx.result)
+			continue
+		}
+
+		go func() {
+			defer func() { <-q.inFlight }()
+			receipt, err := q.client.WaitForReceipt(ctx, hash)
+			tx.result <- TxResult{Hash: hash, Receipt: receipt, Err: err}
+			close(tx.result)
+		}()
+	}
+}
+
+// peek returns the next queued transaction without removing it from the queue.
+//
+// @return The next queued transaction, and false if the queue is empty
+func (q *TxQueue) peek() (queuedTx, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.queued) == 0 {
+		return queuedTx{}, false
+	}
+	return q.queued[0], true
+}
+
+// pop removes the next queued transaction from the queue.
+func (q *TxQueue) pop() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.queued[0] = queuedTx{}
+	q.queued = q.queued[1:]
+}
+
+// send prepares, signs, and sends a queued transaction with the next nonce of the signer. If the transaction cannot be
+// sent, the nonce is resynced from the node, so the reserved nonce doesn't leave a gap.
+//
+// @param ctx Context for the requests
+// @param tx The queued transaction
+// @return Hash of the sent transaction and nil error on success
+// @return Empty hash and error if the transaction cannot be prepared, signed, or sent
+func (q *TxQueue) send(ctx context.Context, tx queuedTx) (common.Hash, error) {
+	from := q.signer.Address()
+	nonce, err := q.nonces.next(ctx, q.client.PendingNonceAt, from)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
+	hash, err := q.sendWithNonce(ctx, tx, nonce)
+	if err != nil {
+		q.nonces.Reset(from)
+		return common.Hash{}, err
+	}
+
+	return hash, nil
+}
+
+// sendWithNonce prepares, signs, and sends a queued transaction with the given nonce.
+//
+// @param ctx Context for the requests
+// @param tx The queued transaction
+// @param nonce The nonce of the transaction
+// @return Hash of the sent transaction and nil error on success
+// @return Empty hash and error if the transaction cannot be prepared, signed, or sent
+func (q *TxQueue) sendWithNonce(ctx context.Context, tx queuedTx, nonce uint64) (common.Hash, error) {
+	prepared, err := q.client.prepareTx(ctx, txParams{
+		data:    tx.data,
+		options: TxOptions{Nonce: &nonce},
+		signer:  q.signer,
+		to:      tx.to,
+		value:   tx.value,
+	})
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	signed, err := q.signer.SignTransaction(prepared)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to sign transaction: %w", err)
+	}
+
+	signed, err = q.client.sendTransaction(ctx, q.signer, signed, q.nonces)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	return signed.Hash(), nil
+}
@@ -0,0 +1,92 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// TxState is the state of a sent transaction, as reported by Client.TransactionStatus.
+type TxState int
+
+const (
+	// TxStatePending indicates that the transaction is waiting in the mempool to be mined
+	TxStatePending TxState = iota
+
+	// TxStateMined indicates that the transaction was mined, successfully or not (see TransactionStatus)
+	TxStateMined
+
+	// TxStateDropped indicates that the transaction will not be mined, because the node no longer knows it, or
+	// another transaction with the same nonce was mined instead
+	TxStateDropped
+)
+
+// String returns a human-readable name for the TxState
+// @return "pending", "mined", "dropped", or "unknown(<state>)" for unrecognized values
+func (s TxState) String() string {
+	switch s {
+	case TxStatePending:
+		return "pending"
+	case TxStateMined:
+		return "mined"
+	case TxStateDropped:
+		return "dropped"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// TransactionStatus returns whether the transaction with the given hash is pending, mined, or dropped, without
+// waiting for it to be mined. A transaction is dropped if it has no receipt and the node doesn't know it, e.g. because
+// it was evicted from the mempool, or if it is still pending but the confirmed nonce of its sender has advanced past
+// its nonce, so it was replaced. A transaction that was sent to another node and has not propagated yet is also
+// reported as dropped, so the status of a transaction just sent to a different node should be checked again later.
+//
+// @param ctx Context for the requests
+// @param hash Hash of the transaction
+// @return The state of the transaction and nil error on success
+// @return TxStatePending and error if the state cannot be retrieved from the network
+func (c *Client) TransactionStatus(ctx context.Context, hash common.Hash) (TxState, error) {
+	ethHash := eth.BytesToHash(hash.Bytes())
+
+	_, err := c.ethClient.TransactionReceipt(ctx, ethHash)
+	if err == nil {
+		return TxStateMined, nil
+	}
+	if !errors.Is(err, eth.ErrNotFound) {
+		return TxStatePending, fmt.Errorf("failed to get transaction receipt: %w", err)
+	}
+
+	tx, isPending, err := c.ethClient.TransactionByHash(ctx, ethHash)
+	if errors.Is(err, eth.ErrNotFound) {
+		return TxStateDropped, nil
+	}
+	if err != nil {
+		return TxStatePending, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if !isPending {
+		// The transaction was mined after the receipt was requested
+		return TxStateMined, nil
+	}
+
+	sender, err := eth.Sender(eth.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return TxStatePending, fmt.Errorf("failed to get transaction sender: %w", err)
+	}
+
+	nonce, err := c.NonceAtTag(ctx, common.NewAddress(sender.Bytes()), common.BlockTagLatest)
+	if err != nil {
+		return TxStatePending, err
+	}
+	if nonce > tx.Nonce() {
+		return TxStateDropped, nil
+	}
+
+	return TxStatePending, nil
+}
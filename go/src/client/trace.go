@@ -0,0 +1,103 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// TraceOptions configures the tracer used by TraceTransaction. The zero value uses the default struct logger.
+type TraceOptions struct {
+	// Tracer is the name of the tracer to use (e.g. "callTracer"), or empty for the default struct logger
+	Tracer string `json:"tracer,omitempty"`
+
+	// TracerConfig is the tracer-specific configuration (e.g. {"onlyTopCall": true} for the callTracer)
+	TracerConfig json.RawMessage `json:"tracerConfig,omitempty"`
+
+	// Timeout is the maximum duration of the trace (e.g. "10s"), or empty for the node default
+	Timeout string `json:"timeout,omitempty"`
+
+	// DisableStack disables capturing the stack in the struct logger
+	DisableStack bool `json:"disableStack,omitempty"`
+
+	// DisableStorage disables capturing storage in the struct logger
+	DisableStorage bool `json:"disableStorage,omitempty"`
+
+	// EnableMemory enables capturing memory in the struct logger
+	EnableMemory bool `json:"enableMemory,omitempty"`
+
+	// EnableReturnData enables capturing return data in the struct logger
+	EnableReturnData bool `json:"enableReturnData,omitempty"`
+}
+
+// StructLogTrace is the trace returned by the default struct logger, which can be decoded from the result of
+// TraceTransaction with json.Unmarshal.
+type StructLogTrace struct {
+	// Gas is the amount of gas used by the transaction
+	Gas uint64 `json:"gas"`
+
+	// Failed reports whether the transaction execution failed
+	Failed bool `json:"failed"`
+
+	// ReturnValue is the hex-encoded data returned by the transaction
+	ReturnValue string `json:"returnValue"`
+
+	// StructLogs are the executed opcodes, in order
+	StructLogs []StructLog `json:"structLogs"`
+}
+
+// StructLog is a single opcode executed by a transaction, as recorded by the default struct logger.
+type StructLog struct {
+	// Pc is the program counter of the opcode
+	Pc uint64 `json:"pc"`
+
+	// Op is the name of the opcode
+	Op string `json:"op"`
+
+	// Gas is the gas remaining before the opcode is executed
+	Gas uint64 `json:"gas"`
+
+	// GasCost is the gas cost of the opcode
+	GasCost uint64 `json:"gasCost"`
+
+	// Depth is the call depth of the opcode
+	Depth int `json:"depth"`
+
+	// Error is the error raised by the opcode, if any
+	Error string `json:"error,omitempty"`
+
+	// Stack is the stack before the opcode is executed, unless disabled
+	Stack []string `json:"stack,omitempty"`
+
+	// Memory is the memory before the opcode is executed, if enabled
+	Memory []string `json:"memory,omitempty"`
+
+	// Storage is the storage accessed by the contract, unless disabled
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+// TraceTransaction returns the execution trace of a mined transaction with debug_traceTransaction, e.g. to find
+// where a transaction reverted. The trace is returned as is, since its format depends on the tracer; the trace of
+// the default struct logger can be decoded into a StructLogTrace.
+//
+// @param ctx Context for the request
+// @param hash Hash of the transaction to trace
+// @param opts Options for the tracer
+// @return The raw trace and nil error on success
+// @return nil and ErrMethodUnsupported if the node does not enable the debug namespace
+// @return nil and error if the transaction cannot be traced
+func (c *Client) TraceTransaction(ctx context.Context, hash common.Hash, opts TraceOptions) (json.RawMessage, error) {
+	var trace json.RawMessage
+	if err := c.ethClient.Client().CallContext(ctx, &trace, "debug_traceTransaction", hash.Hex(), opts); err != nil {
+		if isMethodNotFound(err) {
+			return nil, fmt.Errorf("failed to trace transaction: %w", ErrMethodUnsupported)
+		}
+		return nil, fmt.Errorf("failed to trace transaction: %w", err)
+	}
+	return trace, nil
+}
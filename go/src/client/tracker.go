@@ -0,0 +1,175 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/auth"
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// TxCallback is called by a TxTracker when a tracked transaction is mined. The receipt is set for both successful and
+// failed transactions, and err is set if the transaction failed.
+type TxCallback func(hash common.Hash, receipt *common.Receipt, err error)
+
+// TxTracker sends transactions without waiting for them to be mined, and tracks them by hash until Reconcile finds
+// their receipts. This allows services that submit many transactions to process the results asynchronously, instead
+// of blocking on each transaction. A TxTracker is safe for concurrent use.
+type TxTracker struct {
+	// callback is called when a tracked transaction is mined
+	callback TxCallback
+
+	// client is the Radius Client used to send transactions and fetch receipts
+	client *Client
+
+	// mu guards pending
+	mu sync.Mutex
+
+	// pending maps the hex hash of each outstanding transaction to the transaction
+	pending map[string]trackedTx
+}
+
+// trackedTx is an outstanding transaction of a TxTracker.
+type trackedTx struct {
+	// hash is the hash of the transaction
+	hash common.Hash
+
+	// from is the address of the sender
+	from common.Address
+
+	// to is the address of the recipient, or the zero address for contract deployments
+	to common.Address
+
+	// value is the amount of native currency sent with the transaction in wei
+	value *big.Int
+}
+
+// NewTxTracker creates a new TxTracker that sends transactions with the given Client, and calls the given callback
+// when a tracked transaction is mined.
+//
+// @param client The Radius Client used to send transactions and fetch receipts
+// @param callback Function called with the receipt of each tracked transaction once it is mined
+// @return A new TxTracker instance
+func NewTxTracker(client *Client, callback TxCallback) *TxTracker {
+	return &TxTracker{
+		callback: callback,
+		client:   client,
+		pending:  make(map[string]trackedTx),
+	}
+}
+
+// Pending returns the number of tracked transactions that have not been mined yet.
+//
+// @return The number of outstanding transactions
+func (t *TxTracker) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
+
+// SendTxTracked sends a signed transaction without waiting for it to be mined, and tracks it until Reconcile finds its
+// receipt.
+//
+// @param ctx Context for the request
+// @param tx The signed transaction to send
+// @param signer The signer used to re-sign the transaction, if it is replaced when sending
+// @return Hash of the sent transaction and nil error on success
+// @return Empty hash and error if the transaction cannot be sent
+func (t *TxTracker) SendTxTracked(
+	ctx context.Context,
+	tx *common.SignedTransaction,
+	signer auth.Signer,
+) (common.Hash, error) {
+	if signer == nil {
+		return common.Hash{}, fmt.Errorf("signer is required for sending transactions")
+	}
+	if tx == nil {
+		return common.Hash{}, fmt.Errorf("no signed transaction provided")
+	}
+
+	tx, err := t.client.sendTransaction(ctx, signer, tx, nil)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to send transaction: %w", err)
+	}
+
+	to := common.ZeroAddress()
+	if tx.To != nil {
+		to = *tx.To
+	}
+
+	hash := common.NewHash(tx.EthSignedTransaction().Hash().Bytes())
+
+	t.mu.Lock()
+	t.pending[hash.Hex()] = trackedTx{hash: hash, from: signer.Address(), to: to, value: tx.Value}
+	t.mu.Unlock()
+
+	return hash, nil
+}
+
+// Reconcile polls for the receipts of the outstanding transactions at the poll interval of the Client (see
+// WithPollInterval), and calls the callback of the TxTracker for each transaction that is mined. Failing to fetch a
+// receipt is treated as temporary, so the transaction is polled again. Reconcile blocks until the context is done, so
+// it is usually run in its own goroutine.
+//
+// @param ctx Context for polling, which stops reconciliation when done
+// @return The context error once the context is done
+func (t *TxTracker) Reconcile(ctx context.Context) error {
+	ticker := time.NewTicker(t.client.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		t.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches the receipt of each outstanding transaction once, and calls the callback for each mined transaction.
+//
+// @param ctx Context for the requests
+func (t *TxTracker) poll(ctx context.Context) {
+	t.mu.Lock()
+	outstanding := make([]trackedTx, 0, len(t.pending))
+	for _, tracked := range t.pending {
+		outstanding = append(outstanding, tracked)
+	}
+	t.mu.Unlock()
+
+	for _, tracked := range outstanding {
+		receipt, err := t.client.ethClient.TransactionReceipt(ctx, eth.BytesToHash(tracked.hash.Bytes()))
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// The transaction is not mined yet, or the request failed temporarily
+			continue
+		}
+
+		key := tracked.hash.Hex()
+		t.mu.Lock()
+		_, ok := t.pending[key]
+		delete(t.pending, key)
+		t.mu.Unlock()
+		if !ok || t.callback == nil {
+			continue
+		}
+
+		var failure error
+		if receipt.Status != 1 {
+			failure = fmt.Errorf("transaction failed: status %d, transaction hash %s", receipt.Status, receipt.TxHash)
+		}
+
+		t.callback(tracked.hash, common.ReceiptFromEthReceipt(receipt, tracked.from, tracked.to, tracked.value), failure)
+	}
+}
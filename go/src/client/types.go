@@ -0,0 +1,103 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+)
+
+// Cache is an interface for caching immutable chain data, such as the chain ID and deployed contract code, so it is
+// not requested from the node again. Implementations must be safe for concurrent use, and can be backed by memory
+// (see MemoryCache) or a shared store such as Redis.
+type Cache interface {
+	// Get returns the cached value for the given key.
+	//
+	// @param key Key of the cached value
+	// @return The cached value and true if the key is cached, nil and false otherwise
+	Get(key string) ([]byte, bool)
+
+	// Set caches the value for the given key. Cached values are immutable, so they never expire.
+	//
+	// @param key Key of the value
+	// @param value Value to cache
+	Set(key string, value []byte)
+}
+
+// GasObserver is called after each transaction sent by the Client is mined, whether it succeeded or failed (e.g. ran
+// out of gas), with the name of the contract method that was executed ("constructor" for deployments, or empty for
+// transfers and raw calldata), the gas limit of the transaction, and the gas used by the transaction from its receipt.
+// It is set with the WithGasObserver option.
+type GasObserver func(method string, gasLimit, used uint64)
+
+// Resolver is an interface for resolving human-readable names to Radius addresses.
+// Implementations can be backed by ENS, a custom on-chain registry, or a static lookup table.
+type Resolver interface {
+	// Resolve returns the address associated with the given name.
+	//
+	// @param ctx Context for the request
+	// @param name Name to resolve (e.g. "alice.radius")
+	// @return The resolved address and nil error on success
+	// @return Zero address and error if the name cannot be resolved
+	Resolve(ctx context.Context, name string) (common.Address, error)
+}
+
+// TxIndexer is an interface for looking up the transactions of an account with an indexing service, which is much
+// faster than scanning blocks. It is set with the WithTxIndexer option, and used by Client.TransactionsByAccount.
+type TxIndexer interface {
+	// TransactionsByAccount returns the transactions sent from or to the given address in a range of blocks, in the
+	// order they were mined.
+	//
+	// @param ctx Context for the request
+	// @param address Address of the account
+	// @param fromBlock Number of the first block in the range, or nil for the genesis block
+	// @param toBlock Number of the last block in the range, or nil for the latest block
+	// @return The transactions of the account and nil error on success
+	// @return nil and error if the transactions cannot be retrieved
+	TransactionsByAccount(
+		ctx context.Context,
+		address common.Address,
+		fromBlock *big.Int,
+		toBlock *big.Int,
+	) ([]*common.SignedTransaction, error)
+}
+
+// TxOptions contains optional per-transaction settings used when preparing a transaction.
+// The zero value uses the default behavior of the Client.
+type TxOptions struct {
+	// AccessList is the EIP-2930 access list of the transaction. If set, an EIP-2930 access list transaction is
+	// prepared, or an EIP-1559 dynamic fee transaction with the access list if GasFeeCap is also set.
+	AccessList common.AccessList
+
+	// Gas is the gas limit of the transaction. If set, gas estimation is skipped, which saves a request for
+	// transactions with a known, stable gas cost; if the limit is too low, the transaction runs out of gas and fails.
+	Gas uint64
+
+	// GasFeeCap is the maximum total price per gas unit in wei. If set, an EIP-1559 dynamic fee transaction is
+	// prepared, and GasPrice must be nil.
+	GasFeeCap *big.Int
+
+	// GasPrice is the gas price of the transaction in wei. If nil, the gas price is zero.
+	GasPrice *big.Int
+
+	// GasMultiplier multiplies the gas estimate to get the gas limit, instead of applying the gas margin of the Client,
+	// e.g. 2 for twice the estimate. This gives more headroom to methods whose gas cost varies between the estimate and
+	// execution, such as methods that loop over dynamic data. The gas limit is capped at the max gas of the Client. If
+	// zero, the gas margin is applied. It is ignored if Gas or SkipGasMargin is set.
+	GasMultiplier float64
+
+	// GasTipCap is the maximum priority fee per gas unit in wei of a dynamic fee transaction. If nil, the priority fee
+	// is zero. It requires GasFeeCap to be set.
+	GasTipCap *big.Int
+
+	// Nonce is the nonce of the transaction. If nil, the pending nonce of the signer is used; setting it allows a
+	// pending transaction to be replaced. The NonceManager, if enabled, is bypassed for the transaction.
+	Nonce *uint64
+
+	// SkipGasMargin disables the gas safety margin, using the raw node estimate as the gas limit.
+	// This is useful when the exact gas cost of the transaction has already been measured.
+	SkipGasMargin bool
+}
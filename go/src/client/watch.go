@@ -0,0 +1,249 @@
+// Package client provides the primary interface for interacting with the Radius platform.
+// It implements methods for account management, contract deployment, transaction handling,
+// and querying Radius state.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/contracts"
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
+)
+
+// defaultPollInterval is the interval between polls for new logs when watching events over HTTP
+const defaultPollInterval = time.Second
+
+// WatchEvent watches for events with the given name emitted by the contract, and sends each event to the returned
+// channel with its arguments decoded into the Data of the Event. If the Client is connected over a websocket, a log
+// subscription is used; otherwise, new blocks are polled for logs at the interval set with WithPollInterval. Only
+// events emitted after the watch starts are delivered. Alternatively, you can use the contracts.Contract method
+// WatchEvent, which provides a more convenient interface for interacting with smart contracts.
+//
+// The channel is closed when the returned cancel function is called, the context is done, or the subscription
+// fails. Logs that cannot be decoded as the event are skipped, and failed polls are retried at the next interval. Use
+// WatchEventWithErrors to be notified of these errors instead.
+//
+// @param ctx Context for the watch, which stops watching when done
+// @param contract Contract instance that emits the events
+// @param event Name of the event in the contract ABI
+// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+// @return Channel of decoded events, function to stop watching, and nil error on success
+// @return nil, nil, and error if the contract ABI is missing, the filters are invalid, or the watch cannot start
+func (c *Client) WatchEvent(
+	ctx context.Context,
+	contract *contracts.Contract,
+	event string,
+	indexedFilters ...interface{},
+) (<-chan common.Event, func(), error) {
+	return c.watchEvent(ctx, contract, event, c.pollInterval, nil, indexedFilters)
+}
+
+// WatchEventWithErrors watches for events like WatchEvent, but stops watching at the first error, and sends the error
+// to the returned error channel before the events channel is closed. Errors include a failed log subscription, a
+// failed poll for logs, and a log that cannot be decoded as the event. Stopping the watch with the cancel function or
+// the context is not reported as an error.
+//
+// @param ctx Context for the watch, which stops watching when done
+// @param contract Contract instance that emits the events
+// @param event Name of the event in the contract ABI
+// @param interval Interval between polls for new logs when watching over HTTP, or 0 for the interval set with
+// WithPollInterval
+// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+// @return Channel of decoded events, channel of the error that stopped watching, function to stop watching, and nil
+// error on success
+// @return nil, nil, nil, and error if the contract ABI is missing, the filters are invalid, or the watch cannot start
+func (c *Client) WatchEventWithErrors(
+	ctx context.Context,
+	contract *contracts.Contract,
+	event string,
+	interval time.Duration,
+	indexedFilters ...interface{},
+) (<-chan common.Event, <-chan error, func(), error) {
+	if interval <= 0 {
+		interval = c.pollInterval
+	}
+
+	errs := make(chan error, 1)
+	events, cancel, err := c.watchEvent(ctx, contract, event, interval, errs, indexedFilters)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return events, errs, cancel, nil
+}
+
+// watchEvent watches for events like WatchEvent. If an error channel is given, watching stops at the first error,
+// which is sent to the channel; otherwise, errors are skipped or retried.
+//
+// @param ctx Context for the watch, which stops watching when done
+// @param contract Contract instance that emits the events
+// @param event Name of the event in the contract ABI
+// @param interval Interval between polls for new logs when watching over HTTP
+// @param errs Buffered channel that receives the error that stopped watching, or nil to skip and retry errors
+// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+// @return Channel of decoded events, function to stop watching, and nil error on success
+// @return nil, nil, and error if the contract ABI is missing, the filters are invalid, or the watch cannot start
+func (c *Client) watchEvent(
+	ctx context.Context,
+	contract *contracts.Contract,
+	event string,
+	interval time.Duration,
+	errs chan<- error,
+	indexedFilters []interface{},
+) (<-chan common.Event, func(), error) {
+	if contract.ABI == nil {
+		return nil, nil, fmt.Errorf("contract ABI is required")
+	}
+
+	topics, err := contract.ABI.EventTopics(event, indexedFilters...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	address := contract.Address()
+	query := eth.FilterQuery{
+		Addresses: []eth.Address{address.EthAddress()},
+		Topics:    topics,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	logs := make(chan eth.Log)
+
+	// Subscribe to logs if the connection supports it, and fall back to polling otherwise
+	var subErr, pollErr <-chan error
+	sub, err := c.ethClient.SubscribeFilterLogs(ctx, query, logs)
+	switch {
+	case err == nil:
+		subErr = sub.Err()
+	case errors.Is(err, eth.ErrNotificationsUnsupported):
+		head, err := c.BlockNumber(ctx)
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+
+		var pollErrs chan error
+		if errs != nil {
+			pollErrs = make(chan error, 1)
+			pollErr = pollErrs
+		}
+		go c.pollLogs(ctx, query, head+1, interval, logs, pollErrs)
+	default:
+		cancel()
+		return nil, nil, fmt.Errorf("failed to subscribe to logs: %w", err)
+	}
+
+	// fail reports the error that stops watching, unless watching was stopped by the context
+	fail := func(err error) {
+		if errs != nil && ctx.Err() == nil {
+			errs <- err
+		}
+	}
+
+	events := make(chan common.Event)
+	go func() {
+		defer close(events)
+		if sub != nil {
+			defer sub.Unsubscribe()
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-subErr:
+				if err != nil {
+					fail(fmt.Errorf("log subscription failed: %w", err))
+				}
+				return
+			case err := <-pollErr:
+				fail(err)
+				return
+			case log := <-logs:
+				decoded, err := decodeEvent(contract, event, &log)
+				if err != nil {
+					if errs == nil {
+						continue
+					}
+					fail(err)
+					return
+				}
+
+				select {
+				case events <- decoded:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, cancel, nil
+}
+
+// pollLogs polls for new blocks at the given interval, and sends the logs matching the query in each new block range
+// to the logs channel, until the context is done. If an error channel is given, polling stops at the first failed
+// poll, which is sent to the channel; otherwise, failed polls are retried at the next interval.
+//
+// @param ctx Context for polling, which stops polling when done
+// @param query Filter query used to select logs (the block range is set by pollLogs)
+// @param next The first block to poll for logs
+// @param interval Interval between polls
+// @param logs Channel that matching logs are sent to
+// @param errs Buffered channel that receives the error of a failed poll, or nil to retry failed polls
+func (c *Client) pollLogs(
+	ctx context.Context,
+	query eth.FilterQuery,
+	next uint64,
+	interval time.Duration,
+	logs chan<- eth.Log,
+	errs chan<- error,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		head, err := c.ethClient.BlockNumber(ctx)
+		if err != nil {
+			if errs != nil {
+				errs <- fmt.Errorf("failed to poll block number: %w", err)
+				return
+			}
+			continue
+		}
+		if head < next {
+			continue
+		}
+
+		query.FromBlock = new(big.Int).SetUint64(next)
+		query.ToBlock = new(big.Int).SetUint64(head)
+		found, err := c.ethClient.FilterLogs(ctx, query)
+		if err != nil {
+			if errs != nil {
+				errs <- fmt.Errorf("failed to poll logs: %w", err)
+				return
+			}
+			continue
+		}
+
+		for _, log := range found {
+			select {
+			case logs <- log:
+			case <-ctx.Done():
+				return
+			}
+		}
+		next = head + 1
+	}
+}
@@ -7,10 +7,16 @@
 package common
 
 import (
+	"bytes"
 	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"github.com/radiustechsystems/sdk/go/src/providers/eth"
 )
 
 // ABI represents an Application Binary Interface for smart contracts.
@@ -38,18 +44,154 @@ func NewABI(abiJSON string) (*ABI, error) {
 	return &ABI{abi: parsedABI}, nil
 }
 
+// UnpackTypes decodes ABI-encoded data against the given list of types, e.g. the output of a method that is missing
+// from the contract ABI.
+//
+// @param types Solidity types of the encoded values, in order (e.g. "uint256", "bool", "address[]")
+// @param data ABI-encoded values
+// @return List of decoded values, or an error if a type is invalid or decoding fails
+func UnpackTypes(types []string, data []byte) ([]interface{}, error) {
+	args := make(abi.Arguments, len(types))
+	for i, typeName := range types {
+		t, err := abi.NewType(typeName, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s: %w", typeName, err)
+		}
+		args[i] = abi.Argument{Type: t}
+	}
+
+	values, err := args.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack output: %w", err)
+	}
+
+	return values, nil
+}
+
+// EventID returns the ID of the event with the given name, which is the first topic of the event's logs.
+//
+// @param name Name of the event
+// @return The event ID, or an error if the event is not found
+func (a *ABI) EventID(name string) (Hash, error) {
+	event, ok := a.abi.Events[name]
+	if !ok {
+		return Hash{}, fmt.Errorf("event %s not found in ABI", name)
+	}
+
+	return NewHash(event.ID.Bytes()), nil
+}
+
+// EventName returns the name of the event with the given ID, which is the first topic of the event's logs.
+//
+// @param id The event ID
+// @return The event name, or an error if no event with the ID is found
+func (a *ABI) EventName(id Hash) (string, error) {
+	event, err := a.abi.EventByID(eth.BytesToHash(id.Bytes()))
+	if err != nil {
+		return "", fmt.Errorf("event %s not found in ABI", id.Hex())
+	}
+
+	return event.Name, nil
+}
+
+// EventTopics returns the topic filters that select logs of the event with the given name, for use in log filters and
+// subscriptions. Each indexed filter matches the indexed event argument at the same position, and a nil filter
+// matches any value. Address filters may be given as Radius or Ethereum addresses.
+//
+// @param name Name of the event
+// @param indexedFilters Values to match against the indexed event arguments, in order
+// @return The topic filters, or an error if the event is not found or a filter cannot be encoded
+func (a *ABI) EventTopics(name string, indexedFilters ...interface{}) ([][]eth.Hash, error) {
+	event, ok := a.abi.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found in ABI", name)
+	}
+
+	indexed := 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed++
+		}
+	}
+	if len(indexedFilters) > indexed {
+		return nil, fmt.Errorf("event %s has %d indexed arguments, got %d filters", name, indexed, len(indexedFilters))
+	}
+
+	query := make([][]interface{}, len(indexedFilters))
+	for i, filter := range indexedFilters {
+		switch value := filter.(type) {
+		case nil:
+			continue
+		case Address:
+			query[i] = []interface{}{value.EthAddress()}
+		case *Address:
+			query[i] = []interface{}{value.EthAddress()}
+		default:
+			query[i] = []interface{}{value}
+		}
+	}
+
+	topics, err := abi.MakeTopics(query...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event filters: %w", err)
+	}
+
+	// Anonymous events don't include the event ID as the first topic
+	if event.Anonymous {
+		return topics, nil
+	}
+
+	return append([][]eth.Hash{{event.ID}}, topics...), nil
+}
+
+// IsView returns whether the method with the given name is read-only, i.e. declared view or pure (or constant in
+// older ABIs), so calling it cannot change the contract state.
+//
+// @param name Name of the method
+// @return true if the method is read-only, or an error if the method is not found
+func (a *ABI) IsView(name string) (bool, error) {
+	method, ok := a.abi.Methods[name]
+	if !ok {
+		return false, a.methodNotFound(name)
+	}
+	return method.IsConstant(), nil
+}
+
+// MethodSelectors returns the function selector of each method in the ABI, which is the first 4 bytes of the calldata
+// of a method call.
+//
+// @return Map of function selectors keyed by method name
+func (a *ABI) MethodSelectors() map[string][4]byte {
+	selectors := make(map[string][4]byte, len(a.abi.Methods))
+	for name, method := range a.abi.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		selectors[name] = selector
+	}
+	return selectors
+}
+
 // Pack encodes contract input data for method calls or constructor invocations.
 //
 // @param name Name of the method to call, or an empty string for constructor
 // @param args Variadic list of arguments for the method
 // @return Encoded binary data ready for contract interaction, or an error if the method is not found or encoding fails
 func (a *ABI) Pack(name string, args ...interface{}) ([]byte, error) {
+	args, err := a.normalizeArgs(name, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack arguments: %w", err)
+	}
+
 	// Special case for constructor
 	if name == "" {
 		return a.abi.Pack("", args...)
 	}
 
 	// Regular method call
+	if _, ok := a.abi.Methods[name]; !ok {
+		return nil, a.methodNotFound(name)
+	}
+
 	data, err := a.abi.Pack(name, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to pack arguments: %w", err)
@@ -71,7 +213,145 @@ func (a *ABI) Unpack(name string, data []byte) ([]interface{}, error) {
 
 	method, ok := a.abi.Methods[name]
 	if !ok {
-		return nil, fmt.Errorf("method %s not found in ABI", name)
+		return nil, a.methodNotFound(name)
+	}
+
+	// Outputs are decoded by position, so unnamed outputs don't collide. Arrays are decoded as Go slices (or arrays for
+	// fixed-size arrays) and tuples as Go structs.
+	values, err := method.Outputs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack output: %w", err)
+	}
+
+	return values, nil
+}
+
+// UnpackConstructor decodes the constructor arguments of a contract from the data of its deployment transaction, e.g. to
+// verify how a contract was deployed. The deployment data is the contract creation bytecode followed by the encoded
+// constructor arguments, so the creation bytecode (not the runtime bytecode returned by CodeAt) must be given.
+//
+// @param deploymentData The data of the deployment transaction
+// @param creationBytecode The contract creation bytecode, without constructor arguments
+// @return List of decoded constructor arguments, or an error if the deployment data does not start with the creation
+// bytecode or decoding fails
+func (a *ABI) UnpackConstructor(deploymentData, creationBytecode []byte) ([]interface{}, error) {
+	if !bytes.HasPrefix(deploymentData, creationBytecode) {
+		return nil, fmt.Errorf("deployment data does not start with the creation bytecode")
+	}
+
+	values, err := a.abi.Constructor.Inputs.Unpack(deploymentData[len(creationBytecode):])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack constructor arguments: %w", err)
+	}
+
+	return values, nil
+}
+
+// UnpackEvent decodes the indexed topics and non-indexed data of an event log into a map keyed by argument name.
+// Indexed arguments of dynamic types (e.g. string, bytes) are stored in logs as hashes, so they are decoded as hashes.
+//
+// @param name Name of the event that produced the log
+// @param event The event log to decode
+// @return Map of decoded event arguments keyed by argument name, or an error if the event is not found, the log was
+// not produced by the event, or decoding fails
+func (a *ABI) UnpackEvent(name string, event Event) (map[string]interface{}, error) {
+	abiEvent, ok := a.abi.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found in ABI", name)
+	}
+
+	topics := make([]eth.Hash, len(event.Topics))
+	for i := range event.Topics {
+		topics[i] = eth.BytesToHash(event.Topics[i].Bytes())
+	}
+
+	if !abiEvent.Anonymous {
+		if len(topics) == 0 || topics[0] != abiEvent.ID {
+			return nil, fmt.Errorf("log is not a %s event", name)
+		}
+		topics = topics[1:]
+	}
+
+	result := make(map[string]interface{})
+	if err := abiEvent.Inputs.NonIndexed().UnpackIntoMap(result, event.Raw); err != nil {
+		return nil, fmt.Errorf("failed to unpack event data: %w", err)
+	}
+
+	var indexed abi.Arguments
+	for _, input := range abiEvent.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(result, indexed, topics); err != nil {
+		return nil, fmt.Errorf("failed to unpack event topics: %w", err)
+	}
+
+	return result, nil
+}
+
+// UnpackEventInto decodes the indexed topics and non-indexed data of an event log into the struct pointed to by out.
+// Each event argument is stored in the exported field tagged with `abi:"<name>"`, or else the field named after the
+// argument in camel case (e.g. "value" is stored in Value), and arguments without a matching field are skipped. Address
+// and hash arguments can be stored in fields of either the Radius or go-ethereum types.
+//
+// @param name Name of the event that produced the log
+// @param event The event log to decode
+// @param out Pointer to the struct to store the decoded arguments in
+// @return An error if the event is not found, the log was not produced by the event, decoding fails, or an argument
+// cannot be stored in its field
+func (a *ABI) UnpackEventInto(name string, event Event, out interface{}) error {
+	target := reflect.ValueOf(out)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("output must be a non-nil pointer to a struct, got %T", out)
+	}
+	target = target.Elem()
+
+	values, err := a.UnpackEvent(name, event)
+	if err != nil {
+		return err
+	}
+
+	// Map argument names to fields, preferring abi tags over field names
+	fields := make(map[string]reflect.Value)
+	targetType := target.Type()
+	for i := 0; i < targetType.NumField(); i++ {
+		if field := targetType.Field(i); field.IsExported() && field.Tag.Get("abi") == "" {
+			fields[field.Name] = target.Field(i)
+		}
+	}
+	for i := 0; i < targetType.NumField(); i++ {
+		if field := targetType.Field(i); field.IsExported() && field.Tag.Get("abi") != "" {
+			fields[field.Tag.Get("abi")] = target.Field(i)
+		}
+	}
+
+	for _, input := range a.abi.Events[name].Inputs {
+		field, ok := fields[input.Name]
+		if !ok {
+			field, ok = fields[abi.ToCamelCase(input.Name)]
+		}
+		if !ok {
+			continue
+		}
+
+		if err := setField(field, values[input.Name]); err != nil {
+			return fmt.Errorf("failed to store argument %s: %w", input.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackToMap decodes contract output data returned from a method call into a map keyed by output name.
+//
+// @param name Name of the method that produced the output
+// @param data Encoded binary data received from the contract
+// @return Map of decoded values keyed by output name, or an error if the method is not found or decoding fails
+func (a *ABI) UnpackToMap(name string, data []byte) (map[string]interface{}, error) {
+	method, ok := a.abi.Methods[name]
+	if !ok {
+		return nil, a.methodNotFound(name)
 	}
 
 	result := make(map[string]interface{})
@@ -79,10 +359,175 @@ func (a *ABI) Unpack(name string, data []byte) ([]interface{}, error) {
 		return nil, fmt.Errorf("failed to unpack output: %w", err)
 	}
 
-	values := make([]interface{}, len(method.Outputs))
-	for i, output := range method.Outputs {
-		values[i] = result[output.Name]
+	return result, nil
+}
+
+// methodNotFound returns an error for a method that is not found in the ABI, which suggests the closest method name
+// if it is likely a typo, and lists the available methods.
+//
+// @param name Name of the method that was not found
+// @return Error describing the missing method
+func (a *ABI) methodNotFound(name string) error {
+	names := make([]string, 0, len(a.abi.Methods))
+	for methodName := range a.abi.Methods {
+		names = append(names, methodName)
 	}
+	if len(names) == 0 {
+		return fmt.Errorf("method %s not found in ABI, which has no methods", name)
+	}
+	sort.Strings(names)
 
-	return values, nil
+	// Suggest the closest name if it is within a third of the length of the name, so unrelated names aren't suggested
+	closest, closestDistance := "", len(name)/3+1
+	for _, methodName := range names {
+		if distance := levenshtein(strings.ToLower(name), strings.ToLower(methodName)); distance < closestDistance {
+			closest, closestDistance = methodName, distance
+		}
+	}
+
+	available := strings.Join(names, ", ")
+	if closest != "" {
+		return fmt.Errorf("method %s not found in ABI, did you mean %s? (available methods: %s)", name, closest, available)
+	}
+	return fmt.Errorf("method %s not found in ABI (available methods: %s)", name, available)
+}
+
+// levenshtein returns the Levenshtein distance between two strings, which is the minimum number of single character
+// insertions, deletions, or substitutions needed to change one string into the other.
+func levenshtein(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	previous := make([]int, len(t)+1)
+	current := make([]int, len(t)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		current[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(t)]
+}
+
+// normalizeArgs converts decimal or hex string arguments for integer parameters to the Go type expected by the
+// parameter (e.g. *big.Int for uint256, uint8 for uint8), so large values don't need to be constructed manually.
+// Arguments for methods that are not found, or that have an unexpected number of arguments, are returned as is so
+// the error is reported when packing.
+//
+// @param name Name of the method, or an empty string for constructor
+// @param args Arguments for the method
+// @return The normalized arguments, or an error if a string argument is not a valid integer or overflows its type
+func (a *ABI) normalizeArgs(name string, args []interface{}) ([]interface{}, error) {
+	inputs := a.abi.Constructor.Inputs
+	if name != "" {
+		method, ok := a.abi.Methods[name]
+		if !ok {
+			return args, nil
+		}
+		inputs = method.Inputs
+	}
+
+	if len(inputs) != len(args) {
+		return args, nil
+	}
+
+	normalized := make([]interface{}, len(args))
+	for i, arg := range args {
+		normalized[i] = arg
+
+		s, ok := arg.(string)
+		t := inputs[i].Type
+		if !ok || (t.T != abi.IntTy && t.T != abi.UintTy) {
+			continue
+		}
+
+		value, err := parseInteger(s, t)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d (%s): %w", i, inputs[i].Name, err)
+		}
+		normalized[i] = value
+	}
+
+	return normalized, nil
+}
+
+// setField stores a decoded ABI value in a struct field, converting go-ethereum addresses and hashes to the Radius
+// types if the field has a Radius type.
+//
+// @param field The struct field to store the value in
+// @param value The decoded value
+// @return An error if the value cannot be stored in the field
+func setField(field reflect.Value, value interface{}) error {
+	switch v := value.(type) {
+	case eth.Address:
+		value = NewAddress(v.Bytes())
+		if field.Type() == reflect.TypeOf(v) {
+			value = v
+		}
+	case eth.Hash:
+		value = NewHash(v.Bytes())
+		if field.Type() == reflect.TypeOf(v) {
+			value = v
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() || !rv.Type().AssignableTo(field.Type()) {
+		return fmt.Errorf("cannot assign %T to field of type %s", value, field.Type())
+	}
+	field.Set(rv)
+	return nil
+}
+
+// parseInteger parses a decimal or 0x-prefixed hex string into the Go type expected by the given integer ABI type.
+//
+// @param s Decimal or hex string representation of the integer
+// @param t Integer ABI type of the parameter
+// @return The parsed integer, or an error if the string is not a valid integer or overflows the type
+func parseInteger(s string, t abi.Type) (interface{}, error) {
+	// Only decimal and 0x-prefixed hex are accepted, so e.g. "0100" is not parsed as octal
+	sign, digits, base := "", s, 10
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if strings.HasPrefix(digits, "0x") || strings.HasPrefix(digits, "0X") {
+		digits, base = digits[2:], 16
+	}
+	if digits == "" || digits[0] == '+' || digits[0] == '-' {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	value, ok := new(big.Int).SetString(sign+digits, base)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+
+	// Determine the range of values allowed by the type
+	lower, upper := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), uint(t.Size))
+	if t.T == abi.IntTy {
+		upper.Rsh(upper, 1)
+		lower.Neg(upper)
+	}
+	if value.Cmp(lower) < 0 || value.Cmp(upper) >= 0 {
+		return nil, fmt.Errorf("value %s overflows %s", s, t.String())
+	}
+
+	// Types up to 64 bits with a native Go equivalent are packed from the native type
+	goType := t.GetType()
+	switch goType.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.ValueOf(value.Int64()).Convert(goType).Interface(), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflect.ValueOf(value.Uint64()).Convert(goType).Interface(), nil
+	default:
+		return value, nil
+	}
 }
@@ -2,6 +2,7 @@ package common
 
 import (
 	"bytes"
+	"sort"
 
 	"github.com/radiustechsystems/sdk/go/src/providers/eth"
 )
@@ -10,6 +11,10 @@ import (
 // This struct provides methods to convert between different address representations
 // and compare addresses. It serves as the core data structure for identifying
 // accounts and smart contracts in the Radius system.
+//
+// Address is a distinct type from eth.Address (the go-ethereum common.Address), so one cannot be passed where the
+// other is expected. radius.Address is an alias of this type, not of the go-ethereum type. Convert a go-ethereum
+// address with AddressFromEth, and back with Address.EthAddress.
 type Address struct {
 	// data is the underlying 20-byte address data
 	data [20]byte
@@ -25,6 +30,14 @@ func NewAddress(b []byte) Address {
 	return a
 }
 
+// AddressFromEth converts an eth.Address to a Radius Address. This is the inverse of Address.EthAddress.
+//
+// @param address eth.Address to convert
+// @return Address with the same bytes as the eth.Address
+func AddressFromEth(address eth.Address) Address {
+	return Address{data: address}
+}
+
 // Bytes returns the address as a byte slice.
 //
 // @return Byte slice representation of the 20-byte address
@@ -33,7 +46,7 @@ func (a *Address) Bytes() []byte {
 }
 
 // EthAddress converts a Radius Address to an eth.Address.
-// This method is used when Ethereum library functionality is needed.
+// This method is used when Ethereum library functionality is needed. It is the inverse of AddressFromEth.
 //
 // @return eth.Address representation of the Radius address
 func (a *Address) EthAddress() eth.Address {
@@ -64,3 +77,82 @@ func (a *Address) Hex() string {
 func (a *Address) Equals(other Address) bool {
 	return bytes.Equal(a.data[:], other.data[:])
 }
+
+// Compare compares this address with another address by their bytes, which orders addresses as their lowercase hex
+// representations are ordered.
+//
+// @param other Address to compare with this address
+// @return -1 if this address is less than the other, 0 if they are equal, and 1 if it is greater
+func (a *Address) Compare(other Address) int {
+	return bytes.Compare(a.data[:], other.data[:])
+}
+
+// SortAddresses sorts the addresses in place in ascending byte order, so that lists of addresses built in different
+// orders (e.g. access-control lists) have a deterministic order. Equal addresses keep their relative order.
+//
+// @param addresses The addresses to sort
+func SortAddresses(addresses []Address) {
+	sort.SliceStable(addresses, func(i, j int) bool {
+		return addresses[i].Compare(addresses[j]) < 0
+	})
+}
+
+// DedupeAddresses returns the addresses with duplicates removed, keeping the first occurrence of each address. Since
+// addresses are compared by their bytes, addresses parsed from hex strings with different letter case are equal.
+//
+// @param addresses The addresses to deduplicate
+// @return A new slice with each address once, in the order of their first occurrence
+func DedupeAddresses(addresses []Address) []Address {
+	seen := make(AddressSet, len(addresses))
+	deduped := make([]Address, 0, len(addresses))
+	for _, address := range addresses {
+		if !seen.Contains(address) {
+			seen.Add(address)
+			deduped = append(deduped, address)
+		}
+	}
+	return deduped
+}
+
+// AddressSet is a set of addresses. The zero value is not usable, so create an AddressSet with NewAddressSet or make.
+type AddressSet map[Address]struct{}
+
+// NewAddressSet creates a new AddressSet containing the given addresses.
+//
+// @param addresses The initial addresses of the set
+// @return A new AddressSet instance
+func NewAddressSet(addresses ...Address) AddressSet {
+	set := make(AddressSet, len(addresses))
+	for _, address := range addresses {
+		set.Add(address)
+	}
+	return set
+}
+
+// Add adds the address to the set.
+//
+// @param address The address to add
+func (s AddressSet) Add(address Address) {
+	s[address] = struct{}{}
+}
+
+// Contains reports whether the address is in the set.
+//
+// @param address The address to check
+// @return true if the address is in the set, false otherwise
+func (s AddressSet) Contains(address Address) bool {
+	_, ok := s[address]
+	return ok
+}
+
+// Slice returns the addresses in the set, sorted as by SortAddresses so the order is deterministic.
+//
+// @return A new slice with the addresses in the set
+func (s AddressSet) Slice() []Address {
+	addresses := make([]Address, 0, len(s))
+	for address := range s {
+		addresses = append(addresses, address)
+	}
+	SortAddresses(addresses)
+	return addresses
+}
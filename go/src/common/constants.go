@@ -1,3 +1,24 @@
 package common
 
 const MaxGas = uint64(1319413953330)
+
+// TransferGas is the gas cost of a plain value transfer to an account without code, with no data or access list.
+const TransferGas = uint64(21000)
+
+// Block tags used to select the state a query is made against.
+const (
+	// BlockTagLatest selects the state of the latest mined block
+	BlockTagLatest = "latest"
+
+	// BlockTagPending selects the state including pending transactions
+	BlockTagPending = "pending"
+
+	// BlockTagEarliest selects the state of the genesis block
+	BlockTagEarliest = "earliest"
+
+	// BlockTagSafe selects the state of the latest safe block
+	BlockTagSafe = "safe"
+
+	// BlockTagFinalized selects the state of the latest finalized block
+	BlockTagFinalized = "finalized"
+)
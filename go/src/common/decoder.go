@@ -0,0 +1,83 @@
+package common
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Decoder decodes events using the ABI registered for the address of the contract that emitted each event. A
+// transaction to one contract may emit events from other contracts it calls, which a single ABI can't decode, so the
+// ABI of each contract is registered with RegisterABI. A Decoder is safe for concurrent use.
+type Decoder struct {
+	// mu guards abis
+	mu sync.RWMutex
+
+	// abis maps the address of each contract to its ABI
+	abis map[Address]*ABI
+}
+
+// NewDecoder creates a new Decoder with no registered ABIs.
+//
+// @return A new Decoder instance
+func NewDecoder() *Decoder {
+	return &Decoder{abis: make(map[Address]*ABI)}
+}
+
+// RegisterABI registers the ABI used to decode events emitted by the contract at the given address, replacing any ABI
+// previously registered for the address.
+//
+// @param address Address of the contract
+// @param abi ABI of the contract
+func (d *Decoder) RegisterABI(address Address, abi *ABI) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.abis[address] = abi
+}
+
+// DecodeEvent decodes the event using the ABI registered for the address of the contract that emitted it. Events
+// from contracts without a registered ABI, and events that are not in the registered ABI, are returned undecoded.
+//
+// @param event The event to decode
+// @return The event with its Name set to the event name and its arguments decoded into Data, and nil error on success
+// @return The undecoded event and error if the event is in the registered ABI, but cannot be decoded
+func (d *Decoder) DecodeEvent(event Event) (Event, error) {
+	d.mu.RLock()
+	abi, ok := d.abis[event.Address]
+	d.mu.RUnlock()
+	if !ok || len(event.Topics) == 0 {
+		return event, nil
+	}
+
+	name, err := abi.EventName(event.Topics[0])
+	if err != nil {
+		return event, nil
+	}
+
+	data, err := abi.UnpackEvent(name, event)
+	if err != nil {
+		return event, fmt.Errorf("failed to decode %s event from %s: %w", name, event.Address.Hex(), err)
+	}
+
+	event.Name = name
+	event.Data = data
+	return event, nil
+}
+
+// DecodeReceipt decodes the events emitted by the transaction of the given receipt, including events emitted by
+// contracts other than the recipient of the transaction, using the ABIs registered for their addresses.
+//
+// @param receipt The receipt of the transaction
+// @return The events in the order they were emitted, decoded as with DecodeEvent, and nil error on success
+// @return nil and error if an event cannot be decoded
+func (d *Decoder) DecodeReceipt(receipt *Receipt) ([]Event, error) {
+	events := make([]Event, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		event, err := d.DecodeEvent(log)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = event
+	}
+
+	return events, nil
+}
@@ -1,8 +1,22 @@
 package common
 
+import (
+	"bytes"
+	"fmt"
+)
+
 // Event represents an EVM contract event emitted during transaction execution.
 // Contains decoded event data and the raw event payload.
 type Event struct {
+	// Address is the address of the contract that emitted the event
+	Address Address
+
+	// BlockNumber is the number of the block the event was emitted in
+	BlockNumber uint64
+
+	// LogIndex is the index of the event log in the block
+	LogIndex uint
+
 	// Name is the name of the event
 	Name string
 
@@ -11,6 +25,13 @@ type Event struct {
 
 	// Raw is the raw data of the event
 	Raw []byte
+
+	// Topics are the topics of the event log. For non-anonymous events, the first topic is the event ID, followed by
+	// the indexed event arguments.
+	Topics []Hash
+
+	// TxHash is the hash of the transaction that emitted the event
+	TxHash Hash
 }
 
 // NewEvent creates a new Event with the given name, data, and raw bytes
@@ -25,3 +46,35 @@ func NewEvent(name string, data map[string]interface{}, raw []byte) *Event {
 		Raw:  raw,
 	}
 }
+
+// DecodeEvents decodes the events produced by the named event of the ABI into values of type T, which is a struct with
+// a field for each event argument as described by ABI.UnpackEventInto. Events with other IDs (e.g. other events of the
+// same contract) are skipped, so the logs of a block range or receipt can be decoded as is. Anonymous events have no
+// ID, so every event is decoded.
+//
+// @param events The events to decode
+// @param abi The ABI containing the event
+// @param eventName Name of the event to decode
+// @return The decoded values in the order of the events, and nil error on success
+// @return nil and error if the event is not found in the ABI, or an event cannot be decoded
+func DecodeEvents[T any](events []Event, abi *ABI, eventName string) ([]T, error) {
+	abiEvent, ok := abi.abi.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %s not found in ABI", eventName)
+	}
+
+	var result []T
+	for i, event := range events {
+		if !abiEvent.Anonymous && (len(event.Topics) == 0 || !bytes.Equal(event.Topics[0].Bytes(), abiEvent.ID.Bytes())) {
+			continue
+		}
+
+		var value T
+		if err := abi.UnpackEventInto(eventName, event, &value); err != nil {
+			return nil, fmt.Errorf("failed to decode event %d: %w", i, err)
+		}
+		result = append(result, value)
+	}
+
+	return result, nil
+}
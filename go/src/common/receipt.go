@@ -1,9 +1,34 @@
 package common
 
 import (
+	"fmt"
 	"math/big"
 )
 
+// TransactionStatus is the execution status of a mined transaction, as reported in its Receipt.
+type TransactionStatus uint64
+
+const (
+	// StatusFailed indicates that the transaction was mined but its execution failed (e.g. it reverted)
+	StatusFailed TransactionStatus = 0
+
+	// StatusSuccess indicates that the transaction was mined and executed successfully
+	StatusSuccess TransactionStatus = 1
+)
+
+// String returns a human-readable name for the TransactionStatus
+// @return "failed", "success", or "unknown(<status>)" for unrecognized values
+func (s TransactionStatus) String() string {
+	switch s {
+	case StatusFailed:
+		return "failed"
+	case StatusSuccess:
+		return "success"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint64(s))
+	}
+}
+
 // Receipt represents the result of a successfully mined transaction.
 // Contains information about the transaction execution including gas usage,
 // emitted events, and contract creation if applicable.
@@ -64,3 +89,42 @@ func NewReceipt(
 		Value:           value,
 	}
 }
+
+// Succeeded reports whether the transaction was executed successfully
+// @return True if the receipt status is StatusSuccess, false otherwise
+func (r *Receipt) Succeeded() bool {
+	return r.TxStatus() == StatusSuccess
+}
+
+// TxStatus returns the status of the receipt as a TransactionStatus
+// @return StatusSuccess, StatusFailed, or the unrecognized status value
+func (r *Receipt) TxStatus() TransactionStatus {
+	return TransactionStatus(r.Status)
+}
+
+// ReceiptBatch is a list of receipts of a batch of transactions, e.g. multiple contract method executions, with
+// helpers to report on the batch as a whole.
+type ReceiptBatch []*Receipt
+
+// TotalGasUsed returns the total amount of gas used by the transactions in the batch
+// @return The sum of the gas used by each receipt, ignoring nil receipts
+func (b ReceiptBatch) TotalGasUsed() uint64 {
+	var total uint64
+	for _, receipt := range b {
+		if receipt != nil {
+			total += receipt.GasUsed
+		}
+	}
+	return total
+}
+
+// AllSucceeded reports whether every transaction in the batch was executed successfully
+// @return True if every receipt status is StatusSuccess (or the batch is empty), false if any receipt failed or is nil
+func (b ReceiptBatch) AllSucceeded() bool {
+	for _, receipt := range b {
+		if receipt == nil || !receipt.Succeeded() {
+			return false
+		}
+	}
+	return true
+}
@@ -7,18 +7,80 @@ import (
 	"github.com/radiustechsystems/sdk/go/src/providers/eth"
 )
 
+// AccessTuple is an address and the storage keys of that address a transaction plans to access.
+type AccessTuple struct {
+	// Address is the address of the account or contract being accessed
+	Address Address
+
+	// StorageKeys are the storage slots of the address being accessed
+	StorageKeys []Hash
+}
+
+// AccessList is an EIP-2930 list of the addresses and storage keys a transaction plans to access.
+// Declaring state access up front makes those accesses cheaper when the transaction is executed.
+type AccessList []AccessTuple
+
+// AccessListFromEth converts an eth.AccessList to a Radius AccessList.
+//
+// @param list The eth.AccessList to convert
+// @return The converted AccessList
+func AccessListFromEth(list eth.AccessList) AccessList {
+	result := make(AccessList, len(list))
+	for i, tuple := range list {
+		keys := make([]Hash, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			keys[j] = NewHash(key.Bytes())
+		}
+		result[i] = AccessTuple{Address: NewAddress(tuple.Address.Bytes()), StorageKeys: keys}
+	}
+	return result
+}
+
+// EthAccessList converts the AccessList to an eth.AccessList.
+//
+// @return The access list converted to an eth.AccessList, or nil if the AccessList is nil
+func (l AccessList) EthAccessList() eth.AccessList {
+	if l == nil {
+		return nil
+	}
+
+	result := make(eth.AccessList, len(l))
+	for i, tuple := range l {
+		keys := make([]eth.Hash, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			keys[j] = eth.BytesToHash(key.Bytes())
+		}
+		result[i] = eth.AccessTuple{Address: tuple.Address.EthAddress(), StorageKeys: keys}
+	}
+	return result
+}
+
 // Transaction is a Radius EVM transaction.
-// Contains all the data needed to execute a Radius transaction.
+// Contains all the data needed to execute a Radius transaction. Transactions are legacy transactions by default,
+// EIP-2930 access list transactions when AccessList is set, and EIP-1559 dynamic fee transactions when GasFeeCap is
+// set.
 type Transaction struct {
+	// AccessList is the list of addresses and storage keys the transaction plans to access (nil for legacy transactions)
+	AccessList AccessList
+
+	// ChainID is the chain ID of a typed transaction (nil to use the chain ID of the signer)
+	ChainID *big.Int
+
 	// Data is the calldata for the transaction (bytecode for contract creation, or method call data)
 	Data []byte
 
 	// Gas is the maximum amount of gas units the transaction can consume
 	Gas uint64
 
-	// GasPrice is the price per gas unit in wei
+	// GasFeeCap is the maximum total price per gas unit in wei of a dynamic fee transaction (nil for other types)
+	GasFeeCap *big.Int
+
+	// GasPrice is the price per gas unit in wei (unused by dynamic fee transactions)
 	GasPrice *big.Int
 
+	// GasTipCap is the maximum priority fee per gas unit in wei of a dynamic fee transaction
+	GasTipCap *big.Int
+
 	// Nonce is the sequential transaction number for the sending account
 	Nonce uint64
 
@@ -29,18 +91,94 @@ type Transaction struct {
 	Value *big.Int
 }
 
-// EthTransaction converts the Radius Transaction to an eth.Transaction.
+// NewAccessListTransaction creates an EIP-2930 access list transaction. The chain ID may be nil, in which case the
+// chain ID of the signer is used when the transaction is signed.
+//
+// @param chainID Chain ID of the network, or nil to use the chain ID of the signer
+// @param nonce Sequential transaction number of the sending account
+// @param to Destination address, or nil for contract creation
+// @param value Amount of native currency to send in wei, or nil for zero
+// @param gas Maximum amount of gas units the transaction can consume
+// @param gasPrice Price per gas unit in wei, or nil for zero
+// @param data Transaction calldata
+// @param accessList Addresses and storage keys the transaction plans to access
+// @return A new access list transaction
+func NewAccessListTransaction(
+	chainID *big.Int,
+	nonce uint64,
+	to *Address,
+	value *big.Int,
+	gas uint64,
+	gasPrice *big.Int,
+	data []byte,
+	accessList AccessList,
+) *Transaction {
+	if accessList == nil {
+		accessList = AccessList{}
+	}
+
+	return &Transaction{
+		AccessList: accessList,
+		ChainID:    chainID,
+		Data:       data,
+		Gas:        gas,
+		GasPrice:   bigOrZero(gasPrice),
+		Nonce:      nonce,
+		To:         to,
+		Value:      bigOrZero(value),
+	}
+}
+
+// NewTransaction creates a legacy transaction. A nil value or gas price defaults to zero.
+//
+// @param nonce Sequential transaction number of the sending account
+// @param to Destination address, or nil for contract creation
+// @param value Amount of native currency to send in wei, or nil for zero
+// @param gas Maximum amount of gas units the transaction can consume
+// @param gasPrice Price per gas unit in wei, or nil for zero
+// @param data Transaction calldata
+// @return A new legacy transaction
+func NewTransaction(nonce uint64, to *Address, value *big.Int, gas uint64, gasPrice *big.Int, data []byte) *Transaction {
+	return &Transaction{
+		Data:     data,
+		Gas:      gas,
+		GasPrice: bigOrZero(gasPrice),
+		Nonce:    nonce,
+		To:       to,
+		Value:    bigOrZero(value),
+	}
+}
+
+// Cost returns the maximum cost of the transaction in wei, which is the gas limit times the gas price, plus the value.
+// For dynamic fee transactions, the fee cap is used as the gas price. A nil gas price or value is treated as zero, so
+// the cost of a transaction with a zero gas price, as is common on Radius, is its value.
+//
+// @return The maximum cost of the transaction in wei
+func (t *Transaction) Cost() *big.Int {
+	price := t.GasPrice
+	if t.GasFeeCap != nil {
+		price = t.GasFeeCap
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(t.Gas), bigOrZero(price))
+	return cost.Add(cost, bigOrZero(t.Value))
+}
+
+// EthTransaction converts the Radius Transaction to an eth.Transaction. Transactions with a GasFeeCap are converted
+// to EIP-1559 dynamic fee transactions, transactions with an AccessList to EIP-2930 access list transactions, and all
+// others to legacy transactions. A nil value or gas price is converted to zero.
 //
 // @return The transaction converted to an eth.Transaction
 func (t *Transaction) EthTransaction() *eth.Transaction {
-	return eth.NewTx(&eth.LegacyTx{
-		Data:     t.Data,
-		Gas:      t.Gas,
-		GasPrice: t.GasPrice,
-		Nonce:    t.Nonce,
-		To:       EthAddressFromRadiusAddress(t.To),
-		Value:    t.Value,
-	})
+	return eth.NewTx(t.txData(nil, nil, nil))
+}
+
+// Typed reports whether the transaction is a typed (EIP-2718) transaction rather than a legacy transaction. Typed
+// transactions commit to the chain ID, so signers set ChainID to their own chain ID if it is nil.
+//
+// @return True if the transaction has an AccessList or GasFeeCap, false otherwise
+func (t *Transaction) Typed() bool {
+	return t.AccessList != nil || t.GasFeeCap != nil
 }
 
 // ToEthTransaction returns the Transaction as an eth.Transaction.
@@ -73,6 +211,16 @@ func (t *Transaction) ToMap() map[string]interface{} {
 		m["to"] = t.To.Hex()
 	}
 
+	if t.AccessList != nil {
+		m["accessList"] = t.AccessList.EthAccessList()
+	}
+
+	if t.GasFeeCap != nil {
+		m["maxFeePerGas"] = fmt.Sprintf("0x%x", t.GasFeeCap)
+		m["maxPriorityFeePerGas"] = fmt.Sprintf("0x%x", bigOrZero(t.GasTipCap))
+		delete(m, "gasPrice")
+	}
+
 	return m
 }
 
@@ -88,27 +236,154 @@ type SignedTransaction struct {
 	// S is the ECDSA signature s value
 	S *big.Int
 
-	// V is the ECDSA signature v value (recovery id)
+	// V is the ECDSA signature v value (the recovery id, which is EIP-155 encoded for legacy transactions)
 	V *big.Int
 
 	// Serialized is the RLP-encoded signed transaction bytes
 	Serialized []byte
 }
 
-// EthSignedTransaction converts the SignedTransaction to an eth.Transaction.
+// DecodeSignedTransaction decodes a serialized signed transaction, such as the raw transaction bytes submitted to a
+// relayer, into a SignedTransaction. Legacy, EIP-2930 access list, and EIP-1559 dynamic fee transactions are supported.
+// The sender is not verified, so use Sender to recover and check it.
+//
+// @param raw The RLP-encoded signed transaction bytes (with the type prefix for typed transactions)
+// @return The decoded signed transaction, with Serialized set to a copy of raw, and nil error on success
+// @return nil and error if the bytes are not a valid signed transaction, or the transaction type is not supported
+func DecodeSignedTransaction(raw []byte) (*SignedTransaction, error) {
+	var tx eth.Transaction
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction: %w", err)
+	}
+
+	decoded := &Transaction{
+		Data:     tx.Data(),
+		Gas:      tx.Gas(),
+		GasPrice: tx.GasPrice(),
+		Nonce:    tx.Nonce(),
+		Value:    tx.Value(),
+	}
+	if to := tx.To(); to != nil {
+		address := NewAddress(to.Bytes())
+		decoded.To = &address
+	}
+
+	switch tx.Type() {
+	case eth.LegacyTxType:
+	case eth.AccessListTxType:
+		decoded.AccessList = AccessListFromEth(tx.AccessList())
+		decoded.ChainID = tx.ChainId()
+	case eth.DynamicFeeTxType:
+		// Dynamic fee transactions are identified by GasFeeCap, so an empty access list is left nil
+		if len(tx.AccessList()) > 0 {
+			decoded.AccessList = AccessListFromEth(tx.AccessList())
+		}
+		decoded.ChainID = tx.ChainId()
+		decoded.GasFeeCap = tx.GasFeeCap()
+		decoded.GasPrice = nil
+		decoded.GasTipCap = tx.GasTipCap()
+	default:
+		return nil, fmt.Errorf("unsupported transaction type %d", tx.Type())
+	}
+
+	v, r, s := tx.RawSignatureValues()
+	return &SignedTransaction{
+		Transaction: decoded,
+		R:           r,
+		S:           s,
+		V:           v,
+		Serialized:  append([]byte(nil), raw...),
+	}, nil
+}
+
+// EthSignedTransaction converts the SignedTransaction to an eth.Transaction of the same type as EthTransaction.
 //
 // @return The signed transaction converted to an eth.Transaction
 func (s *SignedTransaction) EthSignedTransaction() *eth.Transaction {
-	ltx := eth.LegacyTx{
-		Data:     s.Data,
-		Gas:      s.Gas,
-		GasPrice: s.GasPrice,
-		Nonce:    s.Nonce,
-		To:       EthAddressFromRadiusAddress(s.To),
-		Value:    s.Value,
-		R:        s.R,
-		S:        s.S,
-		V:        s.V,
-	}
-	return eth.NewTx(&ltx)
+	return eth.NewTx(s.txData(s.V, s.R, s.S))
+}
+
+// Hash returns the hash of the signed transaction, which is the hash it is identified by once it is broadcast. The hash
+// is deterministic, so it can be recorded (e.g. for an audit trail) before the transaction is sent.
+//
+// @return The transaction hash
+func (s *SignedTransaction) Hash() Hash {
+	return NewHash(s.EthSignedTransaction().Hash().Bytes())
+}
+
+// Sender recovers the address of the account that signed the transaction. The chain ID used to verify the signature
+// is derived from V for legacy transactions, and taken from ChainID for access list transactions.
+//
+// @return The address of the signer and nil error on success
+// @return Zero address and error if the signature is invalid
+func (s *SignedTransaction) Sender() (Address, error) {
+	tx := s.EthSignedTransaction()
+	sender, err := eth.Sender(eth.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return ZeroAddress(), fmt.Errorf("failed to recover sender: %w", err)
+	}
+	return NewAddress(sender.Bytes()), nil
+}
+
+// txData returns the eth.TxData of the type matching the transaction fields, with the given signature values.
+//
+// @param v The signature v value, or nil for an unsigned transaction
+// @param r The signature r value, or nil for an unsigned transaction
+// @param s The signature s value, or nil for an unsigned transaction
+// @return The transaction data
+func (t *Transaction) txData(v, r, s *big.Int) eth.TxData {
+	switch {
+	case t.GasFeeCap != nil:
+		return &eth.DynamicFeeTx{
+			AccessList: t.AccessList.EthAccessList(),
+			ChainID:    t.ChainID,
+			Data:       t.Data,
+			Gas:        t.Gas,
+			GasFeeCap:  t.GasFeeCap,
+			GasTipCap:  bigOrZero(t.GasTipCap),
+			Nonce:      t.Nonce,
+			To:         EthAddressFromRadiusAddress(t.To),
+			Value:      bigOrZero(t.Value),
+			V:          v,
+			R:          r,
+			S:          s,
+		}
+	case t.AccessList != nil:
+		return &eth.AccessListTx{
+			AccessList: t.AccessList.EthAccessList(),
+			ChainID:    t.ChainID,
+			Data:       t.Data,
+			Gas:        t.Gas,
+			GasPrice:   bigOrZero(t.GasPrice),
+			Nonce:      t.Nonce,
+			To:         EthAddressFromRadiusAddress(t.To),
+			Value:      bigOrZero(t.Value),
+			V:          v,
+			R:          r,
+			S:          s,
+		}
+	default:
+		return &eth.LegacyTx{
+			Data:     t.Data,
+			Gas:      t.Gas,
+			GasPrice: bigOrZero(t.GasPrice),
+			Nonce:    t.Nonce,
+			To:       EthAddressFromRadiusAddress(t.To),
+			Value:    bigOrZero(t.Value),
+			V:        v,
+			R:        r,
+			S:        s,
+		}
+	}
+}
+
+// bigOrZero returns the given integer, or zero if it is nil.
+//
+// @param v The integer to check
+// @return The integer, or a new zero integer if it is nil
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
 }
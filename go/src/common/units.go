@@ -0,0 +1,82 @@
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// EtherDecimals is the number of decimals of the native currency, so 1 ether is 10^18 wei
+const EtherDecimals = 18
+
+// FormatEther converts an amount in wei to a decimal ether amount (e.g. "1.5"), always with 18 decimals. Amounts of
+// native currencies with other decimals (see the client's WithNativeDecimals option) are formatted with FormatUnits.
+// @param wei Amount in wei
+// @return The decimal ether amount, without trailing fractional zeros
+func FormatEther(wei *big.Int) string {
+	return FormatUnits(wei, EtherDecimals)
+}
+
+// FormatUnits converts an integer amount of the smallest unit to a decimal amount (e.g. "1.5"), given the number of
+// decimals of the unit. This is the inverse of ParseUnits. Trailing fractional zeros are trimmed, so whole amounts have
+// no fractional part, and a nil amount is formatted as "0".
+// @param value Amount in the smallest unit
+// @param decimals Number of decimals of the unit
+// @return The decimal amount, without trailing fractional zeros
+func FormatUnits(value *big.Int, decimals int) string {
+	if value == nil {
+		return "0"
+	}
+
+	sign := ""
+	if value.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := new(big.Int).Abs(value).String()
+	if decimals <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+
+	whole, fraction := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	if fraction == "" {
+		return sign + whole
+	}
+	return sign + whole + "." + fraction
+}
+
+// ParseEther converts a decimal ether amount (e.g. "1.5") to wei
+// @param amount Decimal ether amount, with up to 18 fractional digits
+// @return The amount in wei, or an error if the amount is not a valid non-negative decimal number
+func ParseEther(amount string) (*big.Int, error) {
+	return ParseUnits(amount, EtherDecimals)
+}
+
+// ParseUnits converts a decimal amount (e.g. "1.5") to an integer amount of the smallest unit, given the number of
+// decimals of the unit (e.g. 18 for ether, or the decimals of an ERC-20 token). The conversion is exact, so amounts
+// with more fractional digits than decimals are rejected rather than rounded.
+// @param amount Decimal amount, with up to the given number of fractional digits
+// @param decimals Number of decimals of the unit
+// @return The amount in the smallest unit, or an error if the amount is not a valid non-negative decimal number
+func ParseUnits(amount string, decimals int) (*big.Int, error) {
+	whole, fraction, _ := strings.Cut(strings.TrimSpace(amount), ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("invalid amount: %q", amount)
+	}
+	if len(fraction) > decimals {
+		return nil, fmt.Errorf("invalid amount: %q has more than %d decimals", amount, decimals)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", decimals-len(fraction))
+	for _, digit := range digits {
+		if digit < '0' || digit > '9' {
+			return nil, fmt.Errorf("invalid amount: %q", amount)
+		}
+	}
+
+	value, _ := new(big.Int).SetString(digits, 10)
+	return value, nil
+}
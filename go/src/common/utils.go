@@ -1,6 +1,7 @@
 package common
 
 import (
+	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -42,6 +43,40 @@ func AddressFromHex(h string) (Address, error) {
 	return NewAddress(addrBytes), nil
 }
 
+// AddressFromHexChecked creates an Address from a hex string like AddressFromHex, but if the hex string contains both
+// uppercase and lowercase letters, it must have a valid EIP-55 checksum. All-lowercase and all-uppercase hex strings
+// carry no checksum, so they are accepted. This catches mistyped addresses that are still valid hex.
+// @param h string representing the address in hexadecimal format (with or without 0x prefix)
+// @return Address instance, or an error if the hex string is invalid or has an invalid checksum
+func AddressFromHexChecked(h string) (Address, error) {
+	address, err := AddressFromHex(h)
+	if err != nil {
+		return Address{}, err
+	}
+
+	cleanHex := strings.TrimPrefix(h, "0x")
+	if cleanHex == strings.ToLower(cleanHex) || cleanHex == strings.ToUpper(cleanHex) {
+		return address, nil
+	}
+
+	if checksummed := address.Hex(); cleanHex != strings.TrimPrefix(checksummed, "0x") {
+		return Address{}, fmt.Errorf("invalid address checksum: %s, expected %s", h, checksummed)
+	}
+
+	return address, nil
+}
+
+// AddressFromPublicKey derives the Address of an ECDSA public key, e.g. a public key recovered from a signature, which
+// is the last 20 bytes of the Keccak-256 hash of the uncompressed public key
+// @param pub The ECDSA public key
+// @return Address instance, or the zero address if the public key is nil
+func AddressFromPublicKey(pub *ecdsa.PublicKey) Address {
+	if pub == nil {
+		return ZeroAddress()
+	}
+	return NewAddress(eth.PubkeyToAddress(*pub).Bytes())
+}
+
 // BytecodeFromHex converts a hex string to a byte slice
 // @param s Hex string (with or without 0x prefix)
 // @return Byte slice representation of the hex string, or nil if the string is not valid hex
@@ -58,6 +93,24 @@ func BytecodeFromHex(s string) []byte {
 	return bytecode
 }
 
+// CreateAddress computes the address of a contract deployed with CREATE by the given deployer with the given nonce
+// @param deployer Address of the account deploying the contract
+// @param nonce Nonce of the deployment transaction
+// @return The address of the deployed contract
+func CreateAddress(deployer Address, nonce uint64) Address {
+	return NewAddress(eth.CreateAddress(deployer.EthAddress(), nonce).Bytes())
+}
+
+// CreateAddress2 computes the address of a contract deployed with CREATE2 by the given deployer (e.g. a CREATE2
+// factory contract)
+// @param deployer Address of the contract executing CREATE2
+// @param salt Salt passed to CREATE2
+// @param initCode Contract creation code, including any encoded constructor arguments
+// @return The address of the deployed contract
+func CreateAddress2(deployer Address, salt [32]byte, initCode []byte) Address {
+	return NewAddress(eth.CreateAddress2(deployer.EthAddress(), salt, eth.Keccak256(initCode)).Bytes())
+}
+
 // EthAddressFromRadiusAddress converts a Radius Address pointer to an Ethereum Address pointer
 // @param address Radius Address pointer
 // @return Ethereum Address pointer, or nil if the input is nil
@@ -69,21 +122,52 @@ func EthAddressFromRadiusAddress(address *Address) *eth.Address {
 	return &ethAddress
 }
 
-// EventsFromEthLogs converts Ethereum logs to Radius events
+// EventsFromEthLogs converts Ethereum logs to Radius events, keeping the block number, log index, and transaction hash
+// of each log
 // @param logs Ethereum logs
 // @return Slice of Radius events
 func EventsFromEthLogs(logs []*eth.Log) []Event {
 	events := make([]Event, len(logs))
 	for i, log := range logs {
+		topics := make([]Hash, len(log.Topics))
+		for j, topic := range log.Topics {
+			topics[j] = NewHash(topic.Bytes())
+		}
+
+		// Anonymous events may be emitted without any topics, so they have no event ID to use as the name
+		name := ""
+		if len(log.Topics) > 0 {
+			name = log.Topics[0].Hex()
+		}
+
 		events[i] = Event{
-			Name: log.Topics[0].Hex(),
-			Data: make(map[string]interface{}),
-			Raw:  log.Data,
+			Address:     NewAddress(log.Address.Bytes()),
+			BlockNumber: log.BlockNumber,
+			LogIndex:    log.Index,
+			Name:        name,
+			Data:        make(map[string]interface{}),
+			Raw:         log.Data,
+			Topics:      topics,
+			TxHash:      NewHash(log.TxHash.Bytes()),
 		}
 	}
 	return events
 }
 
+// FunctionSelector computes the 4-byte function selector of a function signature, which is the first 4 bytes of the
+// Keccak-256 hash of the signature, e.g. 0xa9059cbb for "transfer(address,uint256)". The signature must use the
+// canonical parameter types (e.g. uint256, not uint), and whitespace is removed, so "transfer(address, uint256)" has
+// the same selector.
+// @param sig The function signature, with the function name and parameter types
+// @return The function selector
+func FunctionSelector(sig string) [4]byte {
+	canonical := strings.Join(strings.Fields(sig), "")
+
+	var selector [4]byte
+	copy(selector[:], eth.Keccak256([]byte(canonical)))
+	return selector
+}
+
 // HashFromHex creates a new Hash from a hexadecimal string
 // @param h The hexadecimal string representation of the hash (with or without 0x prefix)
 // @return A pointer to the new Hash instance, or an error if the hex string is invalid
@@ -4,7 +4,11 @@
 package contracts
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"math/big"
+	"sync"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/common"
@@ -12,14 +16,43 @@ import (
 
 // Contract represents an EVM smart contract on the Radius platform.
 // It provides methods to call read-only methods and execute state-changing methods,
-// handling the ABI encoding/decoding automatically.
+// handling the ABI encoding/decoding automatically. A Contract is safe for concurrent use by multiple goroutines, so
+// it can be shared across request handlers, as long as the ABI and GasPresets are not modified while it is shared.
+//
+// A Contract is not bound to a client: each method takes the ContractClient to use, so the same Contract can be used
+// with different clients (e.g. to fail over to another endpoint) without being recreated.
 type Contract struct {
 	// ABI is the contract's Application Binary Interface
 	// Used for encoding and decoding method calls and return values
 	ABI *common.ABI
 
+	// GasPresets are gas limits keyed by method name, used instead of gas estimation when executing those methods
+	// Used to save a request for hot-path methods with a known, stable gas cost
+	GasPresets map[string]uint64
+
 	// address is the contract's address on Radius
 	address common.Address
+
+	// code is the cached contract bytecode, which is set by the first successful call to Code
+	code []byte
+
+	// codeFetch is the request for the code in flight, if any, which concurrent calls to Code wait for
+	codeFetch *codeFetch
+
+	// codeMu guards code and codeFetch
+	codeMu sync.Mutex
+}
+
+// codeFetch is a request for the code of a contract, whose result is shared by concurrent calls to Contract.Code
+type codeFetch struct {
+	// done is closed once the request completes
+	done chan struct{}
+
+	// code is the retrieved code, which is set before done is closed
+	code []byte
+
+	// err is the error of the request, which is set before done is closed
+	err error
 }
 
 // New creates a new Contract with the given ABI and address.
@@ -56,8 +89,175 @@ func (c *Contract) Call(ctx context.Context, client ContractClient, method strin
 	return client.Call(ctx, c, method, args...)
 }
 
+// CallMap executes a contract method call and returns the decoded result keyed by output name. This makes call sites
+// for methods with multiple return values self-documenting, and resilient to the order of the outputs.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param method Name of the method to call on the contract
+// @param args Arguments to pass to the contract method
+// @return Map of decoded return values keyed by output name and nil error on success
+// @return nil and error if the contract ABI is missing
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the contract method call fails
+func (c *Contract) CallMap(ctx context.Context, client ContractClient, method string, args ...interface{}) (map[string]interface{}, error) {
+	return client.CallMap(ctx, c, method, args...)
+}
+
+// CallRaw executes a contract call with the given function selector and ABI-encoded arguments, and returns the raw,
+// undecoded result. This supports contracts without a known ABI, such as proxies or reverse-engineered interfaces, so
+// the contract ABI may be nil. The calldata sent is the selector followed by the arguments.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param selector The 4-byte function selector
+// @param args The ABI-encoded function arguments
+// @return Raw result of the call and nil error on success
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the contract call fails
+func (c *Contract) CallRaw(ctx context.Context, client ContractClient, selector [4]byte, args []byte) ([]byte, error) {
+	return client.CallRaw(ctx, c, rawCalldata(selector, args))
+}
+
+// CallWithTypes executes a contract call with the given function selector and ABI-encoded arguments, like CallRaw, and
+// decodes the result against the given output types. This supports undocumented methods that are missing from the
+// contract ABI, so the contract ABI may be nil.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param selector The 4-byte function selector
+// @param args The ABI-encoded function arguments
+// @param outputTypes Solidity types of the return values, in order (e.g. "uint256", "bool")
+// @return Array of decoded return values and nil error on success
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the contract call fails, an output type is invalid, or the result cannot be decoded
+func (c *Contract) CallWithTypes(
+	ctx context.Context,
+	client ContractClient,
+	selector [4]byte,
+	args []byte,
+	outputTypes []string,
+) ([]interface{}, error) {
+	result, err := c.CallRaw(ctx, client, selector, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.UnpackTypes(outputTypes, result)
+}
+
+// Code returns the deployed bytecode of the contract. The bytecode is retrieved on the first call and cached, so later
+// calls do not make a request. If the retrieval fails, nothing is cached and the next call retries. Empty code is not
+// cached either, since a contract may be deployed to the address later, after which its code never changes. A copy of
+// the cached bytecode is returned, so it can be modified by the caller.
+//
+// Concurrent calls share a single request, and no lock is held while it is in flight, so a caller waiting for the
+// request of another caller returns as soon as its own context is done.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to retrieve the code
+// @return Contract bytecode and nil error on success
+// @return nil and error if the code cannot be retrieved from the network, or the context is done
+func (c *Contract) Code(ctx context.Context, client ContractClient) ([]byte, error) {
+	for {
+		c.codeMu.Lock()
+		if len(c.code) > 0 {
+			code := bytes.Clone(c.code)
+			c.codeMu.Unlock()
+			return code, nil
+		}
+
+		fetch := c.codeFetch
+		if fetch == nil {
+			fetch = &codeFetch{done: make(chan struct{})}
+			c.codeFetch = fetch
+			c.codeMu.Unlock()
+			return c.fetchCode(ctx, client, fetch)
+		}
+		c.codeMu.Unlock()
+
+		select {
+		case <-fetch.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		// The request of another caller may have failed because of its own context, so the cache is checked again,
+		// and the request is retried with this caller's context if it failed
+		if fetch.err == nil {
+			return bytes.Clone(fetch.code), nil
+		}
+	}
+}
+
+// EncodeCall encodes a contract method call without sending it, and returns the target address and calldata. This is
+// used to submit the call through another channel, such as a multisig (e.g. a Safe transaction builder). The calldata
+// is the same as the calldata sent by Execute.
+//
+// @param method Name of the method to call on the contract
+// @param args Arguments to pass to the contract method
+// @return The contract address, the encoded calldata, and nil error on success
+// @return Zero address, nil, and error if the contract ABI is missing
+// @return Zero address, nil, and error if the contract address is missing or zero
+// @return Zero address, nil, and error if the method call cannot be encoded
+func (c *Contract) EncodeCall(method string, args ...interface{}) (to common.Address, data []byte, err error) {
+	if c.ABI == nil {
+		return common.ZeroAddress(), nil, fmt.Errorf("contract ABI is required")
+	}
+	if c.address.Equals(common.ZeroAddress()) {
+		return common.ZeroAddress(), nil, fmt.Errorf("contract address is required")
+	}
+
+	data, err = c.ABI.Pack(method, args...)
+	if err != nil {
+		return common.ZeroAddress(), nil, fmt.Errorf("failed to encode method call: %w", err)
+	}
+
+	return c.address, data, nil
+}
+
+// EncodeCallWithValue encodes a payable contract method call without sending it, and returns the (to, value, data)
+// tuple of the call. This is used to submit the call through another channel, such as a multisig (e.g. a Safe
+// transaction builder).
+//
+// @param value Amount of native currency to send with the call in wei, or nil to send none
+// @param method Name of the method to call on the contract
+// @param args Arguments to pass to the contract method
+// @return The contract address, the value in wei, the encoded calldata, and nil error on success
+// @return Zero address, nil, nil, and error if the method call cannot be encoded (see EncodeCall)
+func (c *Contract) EncodeCallWithValue(
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (to common.Address, amount *big.Int, data []byte, err error) {
+	to, data, err = c.EncodeCall(method, args...)
+	if err != nil {
+		return common.ZeroAddress(), nil, nil, err
+	}
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	return to, value, data, nil
+}
+
+// Equals reports whether the contract is at the same address as the other contract, e.g. to deduplicate or cache
+// contracts. The ABIs are not compared, since the same contract may be used with different ABIs (see WithABI); compare
+// the ABI fields to also check the ABI identity.
+//
+// @param other The contract to compare with
+// @return true if both contracts are at the same address, or both are nil, false otherwise
+func (c *Contract) Equals(other *Contract) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return c.address.Equals(other.address)
+}
+
 // Execute executes a contract method call and returns the transaction receipt. This is used for state-changing contract
-// methods, and requires a transaction to be sent to Radius.
+// methods, and requires a transaction to be sent to Radius. If the method has a gas preset in GasPresets, the preset is
+// used as the gas limit instead of estimating the gas cost.
 //
 // @param ctx Context for the request
 // @param client Radius client instance used to execute the transaction
@@ -72,3 +272,310 @@ func (c *Contract) Call(ctx context.Context, client ContractClient, method strin
 func (c *Contract) Execute(ctx context.Context, client ContractClient, signer auth.Signer, method string, args ...interface{}) (*common.Receipt, error) {
 	return client.Execute(ctx, c, signer, method, args...)
 }
+
+// ExecuteRaw executes a contract transaction with the given function selector and ABI-encoded arguments. This
+// supports contracts without a known ABI, such as proxies or reverse-engineered interfaces, so the contract ABI may be
+// nil. The calldata sent is the selector followed by the arguments.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer used to sign the transaction
+// @param selector The 4-byte function selector
+// @param args The ABI-encoded function arguments
+// @return Transaction receipt after the transaction is mined and nil error on success
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the transaction fails or is reverted
+func (c *Contract) ExecuteRaw(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	selector [4]byte,
+	args []byte,
+) (*common.Receipt, error) {
+	return client.ExecuteRaw(ctx, c, signer, rawCalldata(selector, args))
+}
+
+// ExecuteWithEther executes a payable contract method call with the given value in ether, and returns the transaction
+// receipt. The value is a decimal string (e.g. "1.5"), which is converted to wei exactly.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer used to sign the transaction
+// @param etherAmount Decimal amount of native currency to send with the transaction in ether
+// @param method Name of the method to execute on the contract
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if the ether amount is invalid
+// @return nil and error if the transaction fails or is reverted
+func (c *Contract) ExecuteWithEther(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	etherAmount string,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	value, err := common.ParseEther(etherAmount)
+	if err != nil {
+		return nil, err
+	}
+	return client.ExecuteWithValue(ctx, c, signer, value, method, args...)
+}
+
+// ExecuteWithGasMultiplier executes a contract method call like Execute, but sets the gas limit to the gas estimate
+// times the given multiplier, capped at the max gas of the client, instead of applying the gas margin of the client.
+// This reduces out-of-gas reverts of methods whose gas cost varies between runs, such as methods that loop over
+// dynamic data. A gas preset of the method takes precedence over the multiplier.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer used to sign the transaction
+// @param multiplier Multiplier of the gas estimate, which must be at least 1
+// @param method Name of the method to execute on the contract
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if the contract ABI is missing
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the multiplier is less than 1
+// @return nil and error if the transaction fails or is reverted
+// @return nil and error if the transaction receipt is not returned
+func (c *Contract) ExecuteWithGasMultiplier(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	multiplier float64,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	return client.ExecuteWithOptions(ctx, c, signer, method, ExecuteOptions{GasMultiplier: multiplier}, args...)
+}
+
+// ExecuteWithOptions executes a contract method call with the given value, gas, gas price, and nonce overrides, and
+// returns the transaction receipt. This gives full control over the transaction, e.g. to replace a pending
+// transaction by reusing its nonce with a higher gas price.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer used to sign the transaction
+// @param method Name of the method to execute on the contract
+// @param opts Overrides for the transaction
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if the contract ABI is missing
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the transaction fails or is reverted
+// @return nil and error if the transaction receipt is not returned
+func (c *Contract) ExecuteWithOptions(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	method string,
+	opts ExecuteOptions,
+	args ...interface{},
+) (*common.Receipt, error) {
+	return client.ExecuteWithOptions(ctx, c, signer, method, opts, args...)
+}
+
+// ExecuteWithValue executes a payable contract method call with the given value, and returns the transaction receipt.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to execute the transaction
+// @param signer The signer used to sign the transaction
+// @param value Amount of native currency to send with the transaction in wei
+// @param method Name of the method to execute on the contract
+// @param args Arguments to pass to the contract method
+// @return Transaction receipt after the method execution and nil error on success
+// @return nil and error if the contract ABI is missing
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the transaction fails or is reverted
+// @return nil and error if the transaction receipt is not returned
+func (c *Contract) ExecuteWithValue(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (*common.Receipt, error) {
+	return client.ExecuteWithValue(ctx, c, signer, value, method, args...)
+}
+
+// EstimateGas estimates the gas cost of executing a contract method, using the signer address as the sender. This can
+// be used to preview the cost of a state-changing method before calling Execute.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to estimate the gas cost
+// @param signer The signer whose address is used as the sender of the transaction
+// @param method Name of the method to estimate on the contract
+// @param args Arguments to pass to the contract method
+// @return Estimated gas cost in gas units and nil error on success
+// @return 0 and error if the contract ABI is missing
+// @return 0 and error if the contract address is missing or zero
+// @return 0 and error if the gas estimation fails
+func (c *Contract) EstimateGas(ctx context.Context, client ContractClient, signer auth.Signer, method string, args ...interface{}) (uint64, error) {
+	return client.EstimateContractGas(ctx, c, signer, big.NewInt(0), method, args...)
+}
+
+// EstimateGasWithValue estimates the gas cost of executing a payable contract method with the given value, using the
+// signer address as the sender.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to estimate the gas cost
+// @param signer The signer whose address is used as the sender of the transaction
+// @param value Amount of native currency to send with the transaction in wei
+// @param method Name of the method to estimate on the contract
+// @param args Arguments to pass to the contract method
+// @return Estimated gas cost in gas units and nil error on success
+// @return 0 and error if the contract ABI is missing
+// @return 0 and error if the contract address is missing or zero
+// @return 0 and error if the gas estimation fails
+func (c *Contract) EstimateGasWithValue(
+	ctx context.Context,
+	client ContractClient,
+	signer auth.Signer,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (uint64, error) {
+	return client.EstimateContractGas(ctx, c, signer, value, method, args...)
+}
+
+// FilterEvents returns the events with the given name emitted by the contract in the given block range, with the
+// event arguments decoded into the Data of each Event.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to retrieve the events
+// @param event Name of the event in the contract ABI
+// @param fromBlock First block to search, or nil for the genesis block
+// @param toBlock Last block to search, or nil for the latest block
+// @return Decoded events in the order they were emitted and nil error on success
+// @return nil and error if the contract ABI is missing or the event is not found
+// @return nil and error if the logs cannot be retrieved or decoded
+func (c *Contract) FilterEvents(
+	ctx context.Context,
+	client ContractClient,
+	event string,
+	fromBlock, toBlock *big.Int,
+) ([]common.Event, error) {
+	return client.FilterEvents(ctx, c, event, fromBlock, toBlock)
+}
+
+// ReadVar reads a public state variable of the contract by name. Solidity generates a getter method with the same name
+// for each public state variable, so this is equivalent to Call, but makes reading state discoverable. The getter of a
+// mapping or array takes the keys or indexes as arguments, one for each level of nesting, e.g. ReadVar(ctx, client,
+// "tiers", tierID) for mapping(uint256 => Tier) tiers. The getter of a struct returns its members as separate values,
+// omitting arrays and mappings.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param name Name of the public state variable
+// @param keys Mapping keys or array indexes of the value to read, if any
+// @return Decoded value of the variable, or struct members in order, and nil error on success
+// @return nil and error if the contract ABI is missing or has no getter for the variable
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the call fails
+func (c *Contract) ReadVar(ctx context.Context, client ContractClient, name string, keys ...interface{}) ([]interface{}, error) {
+	return client.Call(ctx, c, name, keys...)
+}
+
+// View calls a read-only contract method with eth_call and returns the decoded result. Unlike Call, the method must be
+// declared view or pure in the contract ABI, which makes the intent explicit for contracts used for off-chain
+// computation (e.g. signature verification), and catches state-changing methods called by mistake, whose changes
+// would be silently discarded. View never sends a transaction.
+//
+// @param ctx Context for the request
+// @param client Radius client instance used to make the call
+// @param method Name of the view or pure method to call on the contract
+// @param args Arguments to pass to the contract method
+// @return Array of decoded return values from the contract method and nil error on success
+// @return nil and error if the contract ABI is missing, or the method is not found or is not view or pure
+// @return nil and error if the contract address is missing or zero
+// @return nil and error if the contract method call fails
+func (c *Contract) View(ctx context.Context, client ContractClient, method string, args ...interface{}) ([]interface{}, error) {
+	if c.ABI == nil {
+		return nil, fmt.Errorf("contract ABI is required")
+	}
+
+	view, err := c.ABI.IsView(method)
+	if err != nil {
+		return nil, err
+	}
+	if !view {
+		return nil, fmt.Errorf("method %s is not view or pure, use Execute to change contract state", method)
+	}
+
+	return client.Call(ctx, c, method, args...)
+}
+
+// WatchEvent watches for events with the given name emitted by the contract, and sends each event to the returned
+// channel with its arguments decoded into the Data of the Event. This is the real-time counterpart to FilterEvents.
+// The channel is closed when the returned cancel function is called, the context is done, or the subscription fails.
+//
+// @param ctx Context for the watch, which stops watching when done
+// @param client Radius client instance used to watch for events
+// @param event Name of the event in the contract ABI
+// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+// @return Channel of decoded events, function to stop watching, and nil error on success
+// @return nil, nil, and error if the contract ABI is missing or the event is not found
+// @return nil, nil, and error if the filters are invalid or the watch cannot start
+func (c *Contract) WatchEvent(
+	ctx context.Context,
+	client ContractClient,
+	event string,
+	indexedFilters ...interface{},
+) (<-chan common.Event, func(), error) {
+	return client.WatchEvent(ctx, c, event, indexedFilters...)
+}
+
+// WithABI returns a copy of the contract with the given ABI, e.g. to call a proxy contract with the ABI of its
+// implementation, or to add methods to a contract that was created with a partial ABI. The copy has the same address,
+// a copy of the GasPresets, and any cached code, and the original contract is not modified.
+//
+// @param abi The ABI to interpret the contract with
+// @return A new Contract instance with the given ABI
+func (c *Contract) WithABI(abi *common.ABI) *Contract {
+	var presets map[string]uint64
+	if c.GasPresets != nil {
+		presets = make(map[string]uint64, len(c.GasPresets))
+		for method, gas := range c.GasPresets {
+			presets[method] = gas
+		}
+	}
+
+	c.codeMu.Lock()
+	code := c.code
+	c.codeMu.Unlock()
+
+	return &Contract{
+		ABI:        abi,
+		GasPresets: presets,
+		address:    c.address,
+		code:       code,
+	}
+}
+
+// fetchCode retrieves the code of the contract for the given request, without holding codeMu during the request, and
+// caches the code if it is deployed. The result is shared with the calls to Code waiting for the request.
+func (c *Contract) fetchCode(ctx context.Context, client ContractClient, fetch *codeFetch) ([]byte, error) {
+	fetch.code, fetch.err = client.CodeAt(ctx, c.address)
+
+	c.codeMu.Lock()
+	if fetch.err == nil && len(fetch.code) > 0 {
+		c.code = fetch.code
+	}
+	c.codeFetch = nil
+	c.codeMu.Unlock()
+	close(fetch.done)
+
+	if fetch.err != nil {
+		return nil, fetch.err
+	}
+	return bytes.Clone(fetch.code), nil
+}
+
+// rawCalldata returns the calldata for a function selector and ABI-encoded arguments.
+func rawCalldata(selector [4]byte, args []byte) []byte {
+	data := make([]byte, 0, len(selector)+len(args))
+	data = append(data, selector[:]...)
+	return append(data, args...)
+}
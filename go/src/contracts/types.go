@@ -5,6 +5,7 @@ package contracts
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/radiustechsystems/sdk/go/src/auth"
 	"github.com/radiustechsystems/sdk/go/src/common"
@@ -26,6 +27,53 @@ type ContractClient interface {
 	// @return nil and error if the contract method call fails
 	Call(ctx context.Context, contract *Contract, method string, args ...interface{}) ([]interface{}, error)
 
+	// CallMap executes a contract method call and returns the decoded result keyed by output name. This is used for
+	// read-only contract methods, and does not require a transaction to be sent to Radius.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param method Name of the method to call on the contract
+	// @param args Arguments to pass to the contract method
+	// @return Map of decoded return values keyed by output name and nil error on success
+	// @return nil and error if the contract ABI is missing
+	// @return nil and error if the contract address is missing or zero
+	// @return nil and error if the contract method call fails
+	CallMap(ctx context.Context, contract *Contract, method string, args ...interface{}) (map[string]interface{}, error)
+
+	// CallRaw executes a contract call with prebuilt calldata and returns the raw, undecoded result. The contract ABI
+	// is not used, so it may be nil.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param data Calldata to send to the contract
+	// @return Raw result of the call and nil error on success
+	// @return nil and error if the contract address is missing or zero
+	// @return nil and error if the contract call fails
+	CallRaw(ctx context.Context, contract *Contract, data []byte) ([]byte, error)
+
+	// CodeAt returns the contract code at the given address.
+	//
+	// @param ctx Context for the request
+	// @param address Address of the contract to retrieve code for
+	// @return Contract bytecode and nil error on success
+	// @return nil and error if the code cannot be retrieved from the network
+	CodeAt(ctx context.Context, address common.Address) ([]byte, error)
+
+	// EstimateContractGas estimates the gas cost of executing a contract method with the given value, using the
+	// signer address as the sender.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param signer The signer whose address is used as the sender of the transaction
+	// @param value Amount of native currency to send with the transaction in wei
+	// @param method Name of the method to estimate on the contract
+	// @param args Arguments to pass to the contract method
+	// @return Estimated gas cost in gas units and nil error on success
+	// @return 0 and error if the contract ABI is missing
+	// @return 0 and error if the contract address is missing or zero
+	// @return 0 and error if the gas estimation fails
+	EstimateContractGas(ctx context.Context, contract *Contract, signer auth.Signer, value *big.Int, method string, args ...interface{}) (uint64, error)
+
 	// Execute executes a contract method that modifies Radius state. This is used for write operations, and
 	// requires a transaction to be sent to Radius.
 	//
@@ -40,4 +88,95 @@ type ContractClient interface {
 	// @return nil and error if the transaction fails or is reverted
 	// @return nil and error if the transaction receipt is not returned
 	Execute(ctx context.Context, contract *Contract, signer auth.Signer, method string, args ...interface{}) (*common.Receipt, error)
+
+	// ExecuteRaw executes a contract transaction with prebuilt calldata. The contract ABI is not used, so it may be
+	// nil.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param signer The signer used to sign the transaction
+	// @param data Calldata to send to the contract
+	// @return Transaction receipt after the transaction is mined and nil error on success
+	// @return nil and error if the contract address is missing or zero
+	// @return nil and error if the transaction fails or is reverted
+	ExecuteRaw(ctx context.Context, contract *Contract, signer auth.Signer, data []byte) (*common.Receipt, error)
+
+	// ExecuteWithOptions executes a contract method that modifies Radius state, using the given value, gas, gas price,
+	// and nonce overrides for the transaction.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param signer The signer used to sign the transaction
+	// @param method Name of the method to execute on the contract
+	// @param opts Overrides for the transaction
+	// @param args Arguments to pass to the contract method
+	// @return Transaction receipt after the method execution and nil error on success
+	// @return nil and error if the contract ABI is missing
+	// @return nil and error if the contract address is missing or zero
+	// @return nil and error if the transaction fails or is reverted
+	// @return nil and error if the transaction receipt is not returned
+	ExecuteWithOptions(ctx context.Context, contract *Contract, signer auth.Signer, method string, opts ExecuteOptions, args ...interface{}) (*common.Receipt, error)
+
+	// ExecuteWithValue executes a payable contract method that modifies Radius state, sending the given value along
+	// with the transaction.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance to interact with
+	// @param signer The signer used to sign the transaction
+	// @param value Amount of native currency to send with the transaction in wei
+	// @param method Name of the method to execute on the contract
+	// @param args Arguments to pass to the contract method
+	// @return Transaction receipt after the method execution and nil error on success
+	// @return nil and error if the contract ABI is missing
+	// @return nil and error if the contract address is missing or zero
+	// @return nil and error if the transaction fails or is reverted
+	// @return nil and error if the transaction receipt is not returned
+	ExecuteWithValue(ctx context.Context, contract *Contract, signer auth.Signer, value *big.Int, method string, args ...interface{}) (*common.Receipt, error)
+
+	// FilterEvents returns the events with the given name emitted by the contract in the given block range, with the
+	// event arguments decoded into the Data of each Event.
+	//
+	// @param ctx Context for the request
+	// @param contract Contract instance that emitted the events
+	// @param event Name of the event in the contract ABI
+	// @param fromBlock First block to search, or nil for the genesis block
+	// @param toBlock Last block to search, or nil for the latest block
+	// @return Decoded events in the order they were emitted and nil error on success
+	// @return nil and error if the contract ABI is missing or the event is not found
+	// @return nil and error if the logs cannot be retrieved or decoded
+	FilterEvents(ctx context.Context, contract *Contract, event string, fromBlock, toBlock *big.Int) ([]common.Event, error)
+
+	// WatchEvent watches for events with the given name emitted by the contract, and sends each event to the returned
+	// channel with its arguments decoded into the Data of the Event. The channel is closed when the returned cancel
+	// function is called, the context is done, or the subscription fails.
+	//
+	// @param ctx Context for the watch, which stops watching when done
+	// @param contract Contract instance that emits the events
+	// @param event Name of the event in the contract ABI
+	// @param indexedFilters Values to match against the indexed event arguments, in order (nil matches any value)
+	// @return Channel of decoded events, function to stop watching, and nil error on success
+	// @return nil, nil, and error if the contract ABI is missing or the event is not found
+	// @return nil, nil, and error if the filters are invalid or the watch cannot start
+	WatchEvent(ctx context.Context, contract *Contract, event string, indexedFilters ...interface{}) (<-chan common.Event, func(), error)
+}
+
+// ExecuteOptions contains optional overrides for a contract method execution. The zero value sends no value, and uses
+// the gas preset of the method or an estimated gas limit, a zero gas price, and the pending nonce of the signer.
+type ExecuteOptions struct {
+	// Value is the amount of native currency to send with the transaction in wei, or nil to send none
+	Value *big.Int
+
+	// Gas is the gas limit of the transaction, or 0 to use the gas preset of the method or estimate the gas limit
+	Gas uint64
+
+	// GasMultiplier multiplies the gas estimate to get the gas limit, instead of applying the gas margin of the client,
+	// or 0 to apply the gas margin. It is ignored if the gas limit is overridden or preset.
+	GasMultiplier float64
+
+	// GasPrice is the gas price of the transaction in wei, or nil for a zero gas price
+	GasPrice *big.Int
+
+	// Nonce is the nonce of the transaction, or nil to use the pending nonce of the signer. Setting it allows a
+	// pending transaction to be replaced.
+	Nonce *uint64
 }
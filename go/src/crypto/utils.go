@@ -5,12 +5,58 @@ package crypto
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/radiustechsystems/sdk/go/src/common"
 )
 
+// EthereumMessagePrefix is the EIP-191 prefix of personal messages, which is followed by the message length and the
+// message before hashing, so signed messages can't be mistaken for transactions.
+const EthereumMessagePrefix = "\x19Ethereum Signed Message:\n"
+
+// LargeMessagePrefix is the prefix of the hashed data of large messages, which is followed by the length and the hash
+// of the data before hashing, so large message signatures can't be mistaken for transactions or personal messages.
+const LargeMessagePrefix = "\x19Radius Large Message:\n"
+
+// GenerateKey generates a new random ECDSA private key on the secp256k1 curve.
+//
+// @return The generated private key and nil error on success
+// @return nil and error if the system source of randomness fails
+func GenerateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.GenerateKey()
+}
+
+// HashMessage returns the EIP-191 hash of a personal message, which is the hash signed by Signer.SignMessage.
+//
+// @param msg The message to hash
+// @return The 32-byte hash of the message
+func HashMessage(msg []byte) []byte {
+	return HashMessageWithPrefix(EthereumMessagePrefix, msg)
+}
+
+// HashMessageWithPrefix returns the hash of a personal message with the given prefix, for chains that use a prefix
+// other than EthereumMessagePrefix. The hash is the Keccak256 hash of the prefix, the decimal message length, and the
+// message.
+//
+// @param prefix The personal message prefix
+// @param msg The message to hash
+// @return The 32-byte hash of the message
+func HashMessageWithPrefix(prefix string, msg []byte) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s%d%s", prefix, len(msg), msg)))
+}
+
+// HashLargeMessage returns the hash signed for large messages by SignLargeMessage. The data is hashed with Keccak256,
+// and the 32-byte hash is then hashed as a personal message with LargeMessagePrefix, so the signature cannot be
+// mistaken for the signature of a transaction or of a personal message.
+//
+// @param data The data to hash
+// @return The 32-byte hash of the large message
+func HashLargeMessage(data []byte) []byte {
+	return HashMessageWithPrefix(LargeMessagePrefix, Keccak256(data))
+}
+
 // HexToECDSA converts a hexadecimal string to an ECDSA private key.
 // The input string should be a hex-encoded string of the private key (with or without 0x prefix).
 //
@@ -21,6 +67,20 @@ func HexToECDSA(key string) (*ecdsa.PrivateKey, error) {
 	return crypto.HexToECDSA(key)
 }
 
+// JoinSignature joins the R, S, and V components of a signature into the 65-byte Ethereum format: [R || S || V].
+// This is the inverse of SplitSignature.
+//
+// @param r The 32-byte signature r value
+// @param s The 32-byte signature s value
+// @param v The signature recovery id
+// @return The 65-byte signature
+func JoinSignature(r, s [32]byte, v byte) []byte {
+	sig := make([]byte, 0, crypto.SignatureLength)
+	sig = append(sig, r[:]...)
+	sig = append(sig, s[:]...)
+	return append(sig, v)
+}
+
 // Keccak256 calculates the Keccak256 hash of the input data.
 // This is the hashing algorithm used by Ethereum for various cryptographic operations.
 // Multiple byte slices will be concatenated before hashing.
@@ -41,6 +101,34 @@ func PubkeyToAddress(p ecdsa.PublicKey) common.Address {
 	return common.NewAddress(crypto.PubkeyToAddress(p).Bytes())
 }
 
+// PublicKeyBytes returns the serialized form of an ECDSA public key.
+// The compressed form is 33 bytes (a 0x02 or 0x03 prefix followed by the X coordinate), and the uncompressed form
+// is 65 bytes (a 0x04 prefix followed by the X and Y coordinates).
+//
+// @param pub The ECDSA public key to serialize
+// @param compressed Whether to return the compressed form of the public key
+// @return The serialized public key bytes, or nil if the public key is nil
+func PublicKeyBytes(pub *ecdsa.PublicKey, compressed bool) []byte {
+	if pub == nil {
+		return nil
+	}
+	if compressed {
+		return crypto.CompressPubkey(pub)
+	}
+	return crypto.FromECDSAPub(pub)
+}
+
+// RecoverPublicKey recovers the ECDSA public key that created the given signature of a digest hash.
+// The signature must be in the Ethereum format: [R || S || V] where V is 0 or 1.
+//
+// @param digest The 32-byte hash that was signed
+// @param sig The 65-byte signature
+// @return The recovered ECDSA public key and nil error on success
+// @return nil and error if the signature is invalid
+func RecoverPublicKey(digest, sig []byte) (*ecdsa.PublicKey, error) {
+	return crypto.SigToPub(digest, sig)
+}
+
 // Sign creates a cryptographic signature of a digest hash using an ECDSA private key.
 // The signature is in the Ethereum format: [R || S || V] where V is 0 or 1.
 //
@@ -51,3 +139,19 @@ func PubkeyToAddress(p ecdsa.PublicKey) common.Address {
 func Sign(digestHash []byte, prv *ecdsa.PrivateKey) (sig []byte, err error) {
 	return crypto.Sign(digestHash, prv)
 }
+
+// SplitSignature splits a 65-byte Ethereum signature ([R || S || V]) into its R, S, and V components, e.g. to pass
+// them separately to an on-chain ecrecover call. V is returned as is, so it is 0 or 1 for signatures created by Sign.
+//
+// @param sig The 65-byte signature
+// @return The r value, s value, recovery id, and nil error on success
+// @return Zero values and error if the signature is not 65 bytes long
+func SplitSignature(sig []byte) (r [32]byte, s [32]byte, v byte, err error) {
+	if len(sig) != crypto.SignatureLength {
+		return r, s, 0, fmt.Errorf("invalid signature length: got %d bytes, want %d", len(sig), crypto.SignatureLength)
+	}
+
+	copy(r[:], sig[:32])
+	copy(s[:], sig[32:64])
+	return r, s, sig[64], nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -20,6 +21,17 @@ import (
 // The SDK has its own concrete implementations of these structures, and we use these
 // aliases only to leverage Ethereum library functionality when needed.
 type (
+	// AccessList is an EIP-2930 list of addresses and storage keys a transaction plans to access.
+	// Used to pre-declare state access in access list transactions.
+	AccessList = types.AccessList
+
+	// AccessListTx is an EIP-2930 access list transaction for Radius.
+	// Used when a transaction carries an access list.
+	AccessListTx = types.AccessListTx
+
+	// AccessTuple is an address and the storage keys it accesses in an AccessList.
+	AccessTuple = types.AccessTuple
+
 	// ABI represents a smart contract's Application Binary Interface.
 	// Used for encoding and decoding interactions with smart contracts.
 	ABI = abi.ABI
@@ -28,6 +40,10 @@ type (
 	// Used to identify accounts and smart contracts in the Radius system.
 	Address = common.Address
 
+	// BatchElem is a single request in a batch of JSON-RPC requests to Radius.
+	// Used to send multiple requests in a single round trip.
+	BatchElem = rpc.BatchElem
+
 	// CallMsg contains parameters for contract method calls in Radius.
 	// Used when calling read-only contract methods.
 	CallMsg = ethereum.CallMsg
@@ -40,10 +56,31 @@ type (
 	// Abstracts the backend used for contract deployment.
 	DeployBackend = bind.DeployBackend
 
+	// DynamicFeeTx is an EIP-1559 dynamic fee transaction for Radius.
+	// Used when a transaction sets a fee cap and priority fee instead of a gas price.
+	DynamicFeeTx = types.DynamicFeeTx
+
 	// EIP155Signer implements standardized transaction signing for Radius.
 	// Used to create signatures for transactions with replay protection.
 	EIP155Signer = types.EIP155Signer
 
+	// FilterQuery contains options for filtering contract event logs in Radius.
+	// Used to select logs by block range, contract address, and topics.
+	FilterQuery = ethereum.FilterQuery
+
+	// Hash represents a 32-byte Keccak-256 hash in Radius.
+	// Used to identify transactions and blocks.
+	Hash = common.Hash
+
+	// HexBig is a big integer that marshals to and from a hex string in JSON-RPC requests and responses.
+	HexBig = hexutil.Big
+
+	// HexBytes is a byte slice that marshals to and from a hex string in JSON-RPC requests and responses.
+	HexBytes = hexutil.Bytes
+
+	// HexUint64 is a uint64 that marshals to and from a hex string in JSON-RPC requests and responses.
+	HexUint64 = hexutil.Uint64
+
 	// Log represents a smart contract event log in Radius.
 	// Contains data emitted by contract events during transaction execution.
 	Log = types.Log
@@ -68,7 +105,26 @@ type (
 	// Contains information about a completed transaction, including status and logs.
 	Receipt = types.Receipt
 
+	// RPCError is an error returned by a Radius JSON-RPC endpoint, which carries the JSON-RPC error code.
+	RPCError = rpc.Error
+
+	// RPCDataError is an error returned by a Radius JSON-RPC endpoint, which carries additional error data (e.g. the
+	// revert data of a failed call).
+	RPCDataError = rpc.DataError
+
 	// RPCClient is a client for making JSON-RPC calls to Radius.
 	// Used for low-level communication with Radius JSON-RPC endpoints.
 	RPCClient = rpc.Client
 )
+
+// These constants are the EIP-2718 types of the supported transaction formats.
+const (
+	// LegacyTxType is the type of legacy transactions.
+	LegacyTxType = types.LegacyTxType
+
+	// AccessListTxType is the type of EIP-2930 access list transactions.
+	AccessListTxType = types.AccessListTxType
+
+	// DynamicFeeTxType is the type of EIP-1559 dynamic fee transactions.
+	DynamicFeeTxType = types.DynamicFeeTxType
+)
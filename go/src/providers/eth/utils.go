@@ -8,19 +8,33 @@ package eth
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"math/big"
 	"net/http"
 	"strings"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// ErrNotFound is returned when a requested item, such as a transaction or receipt, is not known to the node.
+var ErrNotFound = ethereum.NotFound
+
+// ErrNotificationsUnsupported is returned when subscribing over a connection that does not support notifications,
+// such as HTTP. Callers can fall back to polling when it is returned.
+var ErrNotificationsUnsupported = rpc.ErrNotificationsUnsupported
+
+// ErrTxTypeNotSupported is returned when decoding a transaction of a type that is not supported, e.g. a type added by
+// a later upgrade of the network.
+var ErrTxTypeNotSupported = types.ErrTxTypeNotSupported
+
 // BytesToAddress converts a byte slice to an Ethereum address.
 //
 // @param b Byte slice representing the address
@@ -29,6 +43,14 @@ func BytesToAddress(b []byte) Address {
 	return common.BytesToAddress(b)
 }
 
+// BytesToHash converts a byte slice to an Ethereum hash.
+//
+// @param b Byte slice representing the hash
+// @return Hash instance created from bytes
+func BytesToHash(b []byte) Hash {
+	return common.BytesToHash(b)
+}
+
 // CreateAddress deterministically computes a contract address from a deployer address and nonce.
 //
 // @param from Address of the contract deployer
@@ -38,6 +60,42 @@ func CreateAddress(from Address, nonce uint64) Address {
 	return crypto.CreateAddress(from, nonce)
 }
 
+// DecodeHexUint64 decodes a 0x-prefixed hex string without leading zeros, e.g. a JSON-RPC block number.
+//
+// @param s The hex string to decode
+// @return The decoded number and nil error on success
+// @return 0 and error if the string is empty or not a valid hex number
+func DecodeHexUint64(s string) (uint64, error) {
+	return hexutil.DecodeUint64(s)
+}
+
+// Keccak256 calculates the Keccak-256 hash of the concatenated input data.
+//
+// @param data One or more byte slices to hash
+// @return The 32-byte hash of the input data
+func Keccak256(data ...[]byte) []byte {
+	return crypto.Keccak256(data...)
+}
+
+// LatestSignerForChainID creates a signer for a specific chain ID that supports all known transaction types,
+// including legacy EIP-155 and EIP-2930 access list transactions.
+//
+// @param chainID Chain ID of the network
+// @return Signer instance for the chain ID
+func LatestSignerForChainID(chainID *big.Int) Signer {
+	return types.LatestSignerForChainID(chainID)
+}
+
+// CreateAddress2 deterministically computes the address of a contract created with CREATE2.
+//
+// @param from Address of the contract creating the contract (e.g. a CREATE2 factory)
+// @param salt Salt passed to CREATE2
+// @param initCodeHash Keccak-256 hash of the contract creation code
+// @return The computed contract address
+func CreateAddress2(from Address, salt [32]byte, initCodeHash []byte) Address {
+	return crypto.CreateAddress2(from, salt, initCodeHash)
+}
+
 // NewAddress creates an address from a hex string.
 //
 // @param s Hex string representation of the address (with or without 0x prefix)
@@ -70,6 +128,22 @@ func NewRPCClient(url string, httpClient *http.Client) (*rpc.Client, error) {
 	return rpc.DialOptions(context.Background(), url, rpc.WithHTTPClient(httpClient))
 }
 
+// NewHomesteadSigner creates a signer for legacy transactions without replay protection.
+//
+// @return A new signer instance
+func NewHomesteadSigner() Signer {
+	return types.HomesteadSigner{}
+}
+
+// NewLondonSigner creates a signer for a specific chain ID that supports legacy EIP-155, EIP-2930 access list, and
+// EIP-1559 dynamic fee transactions.
+//
+// @param chainID Chain ID to use for the signer
+// @return A new signer instance
+func NewLondonSigner(chainID *big.Int) Signer {
+	return types.NewLondonSigner(chainID)
+}
+
 // NewEIP155Signer creates a new signer for a specific chain ID.
 //
 // @param chainID Chain ID to use for the signer
@@ -95,6 +169,14 @@ func NewABI(abiStr string) (ABI, error) {
 	return abi.JSON(strings.NewReader(abiStr))
 }
 
+// PubkeyToAddress derives the Ethereum address of an ECDSA public key.
+//
+// @param p The ECDSA public key
+// @return The address of the public key
+func PubkeyToAddress(p ecdsa.PublicKey) Address {
+	return crypto.PubkeyToAddress(p)
+}
+
 // Sender extracts the sender address from a signed transaction.
 //
 // @param signer Signer to use for extracting the address
@@ -109,6 +191,16 @@ func Sender(signer Signer, tx *Transaction) (Address, error) {
 	return BytesToAddress(from.Bytes()), nil
 }
 
+// UnpackRevert decodes the revert reason from the revert data of a failed call, which is encoded as Error(string) or
+// Panic(uint256).
+//
+// @param data Revert data returned by the failed call
+// @return The revert reason and nil error on success
+// @return Empty string and error if the data is not a known revert encoding
+func UnpackRevert(data []byte) (string, error) {
+	return abi.UnpackRevert(data)
+}
+
 // WaitMined waits for a transaction to be mined on Ethereum.
 //
 // @param ctx Context for the request (can be used for timeout)
@@ -119,3 +211,14 @@ func Sender(signer Signer, tx *Transaction) (Address, error) {
 func WaitMined(ctx context.Context, b DeployBackend, tx *Transaction) (*Receipt, error) {
 	return bind.WaitMined(ctx, b, tx)
 }
+
+// WaitMinedHash waits for the transaction with the given hash to be mined on Ethereum.
+//
+// @param ctx Context for the request (can be used for timeout)
+// @param b Backend to use for checking transaction status
+// @param hash Hash of the transaction to wait for
+// @return Transaction receipt and nil error on success
+// @return nil and error if waiting fails or times out
+func WaitMinedHash(ctx context.Context, b DeployBackend, hash Hash) (*Receipt, error) {
+	return bind.WaitMinedHash(ctx, b, hash)
+}
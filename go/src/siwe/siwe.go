@@ -0,0 +1,332 @@
+// Package siwe provides Sign-In with Ethereum (EIP-4361) support for Radius accounts. It builds the canonical SIWE
+// message that a wallet signs to authenticate with a service, and verifies signed messages on the service side.
+// Learn more about SIWE here: https://eips.ethereum.org/EIPS/eip-4361
+package siwe
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/radiustechsystems/sdk/go/src/common"
+	"github.com/radiustechsystems/sdk/go/src/crypto"
+)
+
+// Version is the only SIWE message version defined by EIP-4361
+const Version = "1"
+
+// minNonceLength is the minimum number of alphanumeric characters in a SIWE nonce
+const minNonceLength = 8
+
+// header is the text following the domain on the first line of a SIWE message
+const header = " wants you to sign in with your Ethereum account:"
+
+// Message field prefixes, in the order they appear in a SIWE message
+const (
+	prefixURI            = "URI: "
+	prefixVersion        = "Version: "
+	prefixChainID        = "Chain ID: "
+	prefixNonce          = "Nonce: "
+	prefixIssuedAt       = "Issued At: "
+	prefixExpirationTime = "Expiration Time: "
+	prefixNotBefore      = "Not Before: "
+	prefixRequestID      = "Request ID: "
+	prefixResources      = "Resources:"
+)
+
+// Params contains the fields of a SIWE message. Optional fields are omitted from the message when empty.
+type Params struct {
+	// Domain is the domain requesting the sign-in (e.g. "example.com"), which may include a scheme and port
+	Domain string
+
+	// Address is the address of the account signing in
+	Address common.Address
+
+	// Statement is an optional human-readable statement the user agrees to, which must be a single line
+	Statement string
+
+	// URI is the URI of the resource the user is signing in to (e.g. "https://example.com/login")
+	URI string
+
+	// Version is the SIWE message version, or empty for Version
+	Version string
+
+	// ChainID is the chain ID of the network the account belongs to
+	ChainID *big.Int
+
+	// Nonce is a random string chosen by the service to prevent replay attacks, of at least 8 alphanumeric characters
+	Nonce string
+
+	// IssuedAt is the time the message was created
+	IssuedAt time.Time
+
+	// ExpirationTime is the optional time after which the message is no longer valid
+	ExpirationTime time.Time
+
+	// NotBefore is the optional time before which the message is not yet valid
+	NotBefore time.Time
+
+	// RequestID is an optional ID used by the service to refer to the sign-in request
+	RequestID string
+
+	// Resources are optional URIs of resources the user wishes to have resolved as part of the sign-in
+	Resources []string
+}
+
+// BuildMessage builds the canonical SIWE message for the given parameters, which is signed with EIP-191 (e.g. with
+// Signer.SignMessage).
+//
+// @param params The fields of the message
+// @return The SIWE message and nil error on success
+// @return Empty string and error if a field is missing or invalid
+func BuildMessage(params Params) (string, error) {
+	if params.Version == "" {
+		params.Version = Version
+	}
+	if err := params.validate(); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString(params.Domain + header + "\n")
+	b.WriteString(params.Address.Hex() + "\n")
+	b.WriteString("\n")
+	if params.Statement != "" {
+		b.WriteString(params.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(prefixURI + params.URI + "\n")
+	b.WriteString(prefixVersion + params.Version + "\n")
+	b.WriteString(prefixChainID + params.ChainID.String() + "\n")
+	b.WriteString(prefixNonce + params.Nonce + "\n")
+	b.WriteString(prefixIssuedAt + params.IssuedAt.Format(time.RFC3339))
+	if !params.ExpirationTime.IsZero() {
+		b.WriteString("\n" + prefixExpirationTime + params.ExpirationTime.Format(time.RFC3339))
+	}
+	if !params.NotBefore.IsZero() {
+		b.WriteString("\n" + prefixNotBefore + params.NotBefore.Format(time.RFC3339))
+	}
+	if params.RequestID != "" {
+		b.WriteString("\n" + prefixRequestID + params.RequestID)
+	}
+	if len(params.Resources) > 0 {
+		b.WriteString("\n" + prefixResources)
+		for _, resource := range params.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+
+	return b.String(), nil
+}
+
+// ParseMessage parses a SIWE message into its fields.
+//
+// @param message The SIWE message
+// @return The fields of the message and nil error on success
+// @return Empty Params and error if the message is malformed, or a field is missing or invalid
+func ParseMessage(message string) (Params, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) < 4 || !strings.HasSuffix(lines[0], header) || lines[2] != "" {
+		return Params{}, fmt.Errorf("invalid SIWE message header")
+	}
+
+	var (
+		params Params
+		err    error
+	)
+
+	params.Domain = strings.TrimSuffix(lines[0], header)
+	if params.Address, err = common.AddressFromHex(lines[1]); err != nil {
+		return Params{}, fmt.Errorf("invalid SIWE address: %w", err)
+	}
+	// EIP-4361 requires the EIP-55 checksum, so all-lowercase or all-uppercase addresses are rejected as well
+	if checksummed := params.Address.Hex(); lines[1] != checksummed {
+		return Params{}, fmt.Errorf("invalid SIWE address: %s is not EIP-55 checksummed, expected %s", lines[1], checksummed)
+	}
+
+	// The statement is optional, but the blank line that follows it is not
+	rest := lines[3:]
+	if rest[0] != "" {
+		params.Statement = rest[0]
+		rest = rest[1:]
+		if len(rest) == 0 || rest[0] != "" {
+			return Params{}, fmt.Errorf("invalid SIWE message: missing blank line after statement")
+		}
+	}
+	rest = rest[1:]
+
+	// field consumes the next line if it has the given prefix, and returns its value
+	field := func(prefix string, required bool) (string, error) {
+		if len(rest) == 0 || !strings.HasPrefix(rest[0], prefix) {
+			if required {
+				return "", fmt.Errorf("invalid SIWE message: missing %q field", strings.TrimSuffix(prefix, ": "))
+			}
+			return "", nil
+		}
+		value := strings.TrimPrefix(rest[0], prefix)
+		rest = rest[1:]
+		return value, nil
+	}
+
+	// timeField consumes the next line if it has the given prefix, and returns its value as a time
+	timeField := func(prefix string, required bool) (time.Time, error) {
+		value, err := field(prefix, required)
+		if err != nil || value == "" {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid SIWE %q field: %w", strings.TrimSuffix(prefix, ": "), err)
+		}
+		return t, nil
+	}
+
+	if params.URI, err = field(prefixURI, true); err != nil {
+		return Params{}, err
+	}
+	if params.Version, err = field(prefixVersion, true); err != nil {
+		return Params{}, err
+	}
+
+	chainID, err := field(prefixChainID, true)
+	if err != nil {
+		return Params{}, err
+	}
+	var ok bool
+	if params.ChainID, ok = new(big.Int).SetString(chainID, 10); !ok {
+		return Params{}, fmt.Errorf("invalid SIWE chain ID: %s", chainID)
+	}
+
+	if params.Nonce, err = field(prefixNonce, true); err != nil {
+		return Params{}, err
+	}
+	if params.IssuedAt, err = timeField(prefixIssuedAt, true); err != nil {
+		return Params{}, err
+	}
+	if params.ExpirationTime, err = timeField(prefixExpirationTime, false); err != nil {
+		return Params{}, err
+	}
+	if params.NotBefore, err = timeField(prefixNotBefore, false); err != nil {
+		return Params{}, err
+	}
+	if params.RequestID, err = field(prefixRequestID, false); err != nil {
+		return Params{}, err
+	}
+	if len(rest) > 0 && rest[0] == prefixResources {
+		for _, line := range rest[1:] {
+			if !strings.HasPrefix(line, "- ") {
+				return Params{}, fmt.Errorf("invalid SIWE resource: %s", line)
+			}
+			params.Resources = append(params.Resources, strings.TrimPrefix(line, "- "))
+		}
+		rest = nil
+	}
+	if len(rest) > 0 {
+		return Params{}, fmt.Errorf("invalid SIWE message: unexpected line %q", rest[0])
+	}
+
+	if err = params.validate(); err != nil {
+		return Params{}, err
+	}
+
+	return params, nil
+}
+
+// Verify verifies the EIP-191 signature of a SIWE message, and returns the address of the account that signed in.
+// The message must be well-formed, with an EIP-55 checksummed address as EIP-4361 requires, and valid at the current
+// time according to its expiration and not-before times.
+// Verify does not know which domain and nonce the service expects, so the service must also check them, e.g. by
+// comparing the fields returned by ParseMessage with the nonce it issued.
+//
+// @param message The signed SIWE message
+// @param signature The 65-byte signature of the message, with a recovery id of 0/1 or 27/28
+// @return The address of the account that signed the message and nil error on success
+// @return Zero address and error if the message is malformed, expired, or not yet valid
+// @return Zero address and error if the signature is invalid or was not created by the message address
+func Verify(message string, signature []byte) (common.Address, error) {
+	params, err := ParseMessage(message)
+	if err != nil {
+		return common.ZeroAddress(), err
+	}
+
+	now := time.Now()
+	if !params.ExpirationTime.IsZero() && !now.Before(params.ExpirationTime) {
+		return common.ZeroAddress(), fmt.Errorf("SIWE message expired at %s", params.ExpirationTime.Format(time.RFC3339))
+	}
+	if !params.NotBefore.IsZero() && now.Before(params.NotBefore) {
+		return common.ZeroAddress(), fmt.Errorf("SIWE message is not valid before %s", params.NotBefore.Format(time.RFC3339))
+	}
+
+	r, s, v, err := crypto.SplitSignature(signature)
+	if err != nil {
+		return common.ZeroAddress(), err
+	}
+	if v >= 27 {
+		// Wallets commonly return the recovery id offset by 27
+		v -= 27
+	}
+
+	pub, err := crypto.RecoverPublicKey(crypto.HashMessage([]byte(message)), crypto.JoinSignature(r, s, v))
+	if err != nil {
+		return common.ZeroAddress(), fmt.Errorf("invalid SIWE signature: %w", err)
+	}
+
+	signer := crypto.PubkeyToAddress(*pub)
+	if !signer.Equals(params.Address) {
+		return common.ZeroAddress(), fmt.Errorf("SIWE message was signed by %s, not %s", signer.Hex(), params.Address.Hex())
+	}
+
+	return signer, nil
+}
+
+// validate checks that the required fields are set, and that all fields are well-formed.
+//
+// @return nil error if the fields are valid, or an error describing the first invalid field
+func (p *Params) validate() error {
+	if p.Domain == "" || strings.ContainsAny(p.Domain, " \n") {
+		return fmt.Errorf("invalid SIWE domain: %q", p.Domain)
+	}
+	if p.Address.Equals(common.ZeroAddress()) {
+		return fmt.Errorf("SIWE address is required")
+	}
+	if strings.Contains(p.Statement, "\n") {
+		return fmt.Errorf("SIWE statement must be a single line")
+	}
+	if uri, err := url.Parse(p.URI); err != nil || uri.Scheme == "" {
+		return fmt.Errorf("invalid SIWE URI: %q", p.URI)
+	}
+	if p.Version != Version {
+		return fmt.Errorf("unsupported SIWE version: %q", p.Version)
+	}
+	if p.ChainID == nil || p.ChainID.Sign() <= 0 {
+		return fmt.Errorf("SIWE chain ID is required")
+	}
+	if len(p.Nonce) < minNonceLength || !isAlphanumeric(p.Nonce) {
+		return fmt.Errorf("SIWE nonce must be at least %d alphanumeric characters", minNonceLength)
+	}
+	if p.IssuedAt.IsZero() {
+		return fmt.Errorf("SIWE issued at time is required")
+	}
+	for _, resource := range p.Resources {
+		if uri, err := url.Parse(resource); err != nil || uri.Scheme == "" {
+			return fmt.Errorf("invalid SIWE resource: %q", resource)
+		}
+	}
+
+	return nil
+}
+
+// isAlphanumeric reports whether the string contains only ASCII letters and digits.
+//
+// @param s The string to check
+// @return true if the string is alphanumeric, false otherwise
+func isAlphanumeric(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+// Package transport provides HTTP transport mechanisms for the Radius SDK.
+// It includes interceptors and middleware for logging, debugging, and modifying
+// JSON-RPC requests and responses.
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+)
+
+// writeMethods are the JSON-RPC methods that submit transactions, which must not be sent twice
+var writeMethods = map[string]bool{
+	"eth_sendRawTransaction": true,
+	"eth_sendTransaction":    true,
+}
+
+// FailoverRoundTripper is a http.RoundTripper implementation that sends requests to one of several JSON-RPC endpoints
+// of the same network. Requests are sent to the current endpoint, and if the request fails with a connection error, it
+// is retried on the next endpoints in turn, and the first endpoint that responds becomes the current endpoint.
+//
+// Requests that submit transactions (eth_sendRawTransaction and eth_sendTransaction) are only retried if the
+// connection could not be established, since the transaction may otherwise have reached the failed endpoint, and
+// sending it again could submit it twice. The error is returned instead, and the transaction status can be checked
+// before sending it again.
+//
+// A FailoverRoundTripper must not be copied after first use.
+type FailoverRoundTripper struct {
+	// Endpoints are the URLs of the JSON-RPC endpoints, which replace the URL of each request
+	Endpoints []*url.URL
+
+	// Proxied is the underlying RoundTripper that will actually send the request
+	Proxied http.RoundTripper
+
+	// current is the index of the endpoint that requests are sent to first
+	current atomic.Int64
+}
+
+// NewFailoverRoundTripper creates a new FailoverRoundTripper for the given endpoint URLs.
+//
+// @param endpoints The URLs of the JSON-RPC endpoints, in the order they are tried
+// @param proxied The underlying RoundTripper that will actually send the requests
+// @return A new FailoverRoundTripper and nil error on success
+// @return nil and error if no endpoints are given, or an endpoint URL is invalid
+func NewFailoverRoundTripper(endpoints []string, proxied http.RoundTripper) (*FailoverRoundTripper, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+
+	urls := make([]*url.URL, len(endpoints))
+	for i, endpoint := range endpoints {
+		parsed, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+		}
+		urls[i] = parsed
+	}
+
+	return &FailoverRoundTripper{Endpoints: urls, Proxied: proxied}, nil
+}
+
+// RoundTrip implements the http.RoundTripper interface for sending HTTP requests.
+// It sends the request to the current endpoint, and fails over to the next endpoints on connection errors.
+//
+// @param req The HTTP request to send
+// @return The HTTP response and nil error on success
+// @return nil and error if the request fails on every endpoint, or a write request fails after it may have been sent
+func (frt *FailoverRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+	}
+	write := isWriteRequest(body)
+
+	start := int(frt.current.Load())
+	var lastErr error
+	for i := range frt.Endpoints {
+		index := (start + i) % len(frt.Endpoints)
+		endpoint := frt.Endpoints[index]
+
+		// A RoundTripper must not modify the given request, so the endpoint is set on a copy
+		attempt := req.Clone(req.Context())
+		target := *endpoint
+		attempt.URL = &target
+		attempt.Host = target.Host
+		if req.Body != nil {
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+			attempt.ContentLength = int64(len(body))
+		}
+
+		resp, err := frt.Proxied.RoundTrip(attempt)
+		if err == nil {
+			frt.current.Store(int64(index))
+			return resp, nil
+		}
+		lastErr = fmt.Errorf("request to %s failed: %w", endpoint.Redacted(), err)
+
+		if req.Context().Err() != nil || (write && !isDialError(err)) {
+			return nil, lastErr
+		}
+	}
+
+	return nil, lastErr
+}
+
+// isWriteRequest reports whether the request body contains a JSON-RPC request that submits a transaction. If the body
+// cannot be decoded, the request is treated as a write, so it is not retried.
+//
+// @param body The single or batched JSON-RPC request body
+// @return true if the request submits a transaction
+func isWriteRequest(body []byte) bool {
+	if len(body) == 0 {
+		return false
+	}
+
+	messages, _, err := decodeMessages(body)
+	if err != nil {
+		return true
+	}
+
+	for _, message := range messages {
+		var method string
+		if err = json.Unmarshal(message["method"], &method); err == nil && writeMethods[method] {
+			return true
+		}
+	}
+	return false
+}
+
+// isDialError reports whether the error occurred while connecting to the endpoint, before the request was sent.
+//
+// @param err The error returned by the RoundTripper
+// @return true if the connection could not be established
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
@@ -5,8 +5,11 @@ package transport
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strings"
 )
 
 // InterceptingRoundTripper is a http.RoundTripper implementation that intercepts HTTP requests and responses.
@@ -19,8 +22,28 @@ type InterceptingRoundTripper struct {
 	// Logf is an optional logging function to record requests and responses
 	Logf Logf
 
+	// LogLevel controls which requests and responses are logged with Logf. The default, LogAll, logs every request
+	// and response in full.
+	LogLevel LogLevel
+
+	// MaxBodyLog is the maximum number of bytes of each response body that are logged, or 0 to log the whole body.
+	// Only the logged bytes are buffered, and the rest of the body is streamed to the caller, so large responses
+	// (e.g. eth_getLogs results) are not read into memory for logging.
+	MaxBodyLog int
+
 	// Proxied is the underlying RoundTripper that will actually send the request
 	Proxied http.RoundTripper
+
+	// RequestID is an optional function to generate the IDs of JSON-RPC requests, which replace the IDs set by the
+	// JSON-RPC client. The original IDs are restored in batch responses, so the client can match them to the requests.
+	// Responses to single requests are not matched by ID, so they are streamed to the client unchanged.
+	RequestID RequestIDFunc
+
+	// RequestInterceptor is an optional function to intercept and modify requests before they are sent
+	RequestInterceptor RequestInterceptor
+
+	// UserAgent is an optional User-Agent header to send with each request, replacing the default User-Agent
+	UserAgent string
 }
 
 // RoundTrip implements the http.RoundTripper interface for sending HTTP requests.
@@ -32,38 +55,158 @@ type InterceptingRoundTripper struct {
 func (irt InterceptingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	var err error
 
+	if irt.UserAgent != "" {
+		// A RoundTripper must not modify the given request, so the header is set on a copy
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", irt.UserAgent)
+	}
+
+	// Replace the request IDs first, so the generated IDs are seen by the request interceptor and logger
+	var ids map[string]json.RawMessage
+	if irt.RequestID != nil {
+		req, ids, err = rewriteRequestIDs(req, irt.RequestID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Intercept the request before it is logged and sent, so the modified request is used throughout
+	if irt.RequestInterceptor != nil {
+		req, err = irt.RequestInterceptor(req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Clone the request body so it can be read again
 	reqBody := parseRequestBody(req)
 
-	if irt.Logf != nil {
+	if irt.Logf != nil && irt.LogLevel == LogAll {
 		irt.Logf("Request to %s: %s", req.URL, reqBody)
 	}
 
 	// Make the actual request
 	resp, err := irt.Proxied.RoundTrip(req)
 	if err != nil {
+		if irt.Logf != nil && irt.LogLevel == LogErrorsOnly {
+			irt.Logf("Request to %s failed: method %s: %v", req.URL, requestMethods(reqBody), err)
+		}
 		return nil, err
 	}
 
-	// Clone the response body so it can be read again
-	body, err := io.ReadAll(resp.Body)
+	// Log the response body, keeping the full body readable by the caller
+	if irt.Logf != nil && irt.LogLevel == LogAll {
+		body, err := peekResponseBody(resp, irt.MaxBodyLog)
+		if err != nil {
+			return nil, err
+		}
+		irt.Logf("Response from %s: %s", req.URL, body)
+	}
+	if irt.Logf != nil && irt.LogLevel == LogErrorsOnly {
+		if err = irt.logFailure(req, reqBody, resp); err != nil {
+			return nil, err
+		}
+	}
+
+	if irt.Interceptor != nil {
+		resp, err = irt.Interceptor(reqBody, resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if ids != nil {
+		if err = restoreResponseIDs(resp, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// logFailure logs the response if the request failed, either with an HTTP error status or a JSON-RPC error, with the
+// methods of the request. The request body is not logged. Only the first errorPeekLimit bytes of the response body are
+// read to check for errors, so large successful responses are streamed to the caller instead of read into memory.
+//
+// @param req The HTTP request that was sent
+// @param reqBody The request body
+// @param resp The HTTP response, whose body is reset so it can be read again
+// @return nil on success, or error if reading the response body fails
+func (irt InterceptingRoundTripper) logFailure(req *http.Request, reqBody string, resp *http.Response) error {
+	body, err := peekResponseBody(resp, errorPeekLimit)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Log the response body
-	if irt.Logf != nil {
-		irt.Logf("Response from %s: %s", req.URL, string(body))
+	failures := responseErrors(body)
+	if resp.StatusCode < http.StatusBadRequest && len(failures) == 0 {
+		return nil
 	}
 
-	// Set the response body back to its original state so it can be read again
-	resp.Body = io.NopCloser(bytes.NewBuffer(body))
+	if len(failures) == 0 {
+		// The body is not a JSON-RPC error, e.g. an error page of a proxy, so it is logged as is
+		if irt.MaxBodyLog > 0 && len(body) > irt.MaxBodyLog {
+			body = body[:irt.MaxBodyLog] + truncatedSuffix
+		}
+		failures = []string{body}
+	}
 
-	if irt.Interceptor != nil {
-		return irt.Interceptor(reqBody, resp)
+	irt.Logf("Request to %s failed: method %s, status %d: %s",
+		req.URL, requestMethods(reqBody), resp.StatusCode, strings.Join(failures, "; "))
+	return nil
+}
+
+// requestMethods returns the methods of a single or batched JSON-RPC request body, separated by commas.
+//
+// @param reqBody The request body
+// @return The methods of the request, or "unknown" if the body cannot be decoded
+func requestMethods(reqBody string) string {
+	messages, _, err := decodeMessages([]byte(reqBody))
+	if err != nil {
+		return "unknown"
 	}
 
-	return resp, nil
+	methods := make([]string, 0, len(messages))
+	for _, message := range messages {
+		var method string
+		if err = json.Unmarshal(message["method"], &method); err == nil {
+			methods = append(methods, method)
+		}
+	}
+	if len(methods) == 0 {
+		return "unknown"
+	}
+	return strings.Join(methods, ", ")
+}
+
+// responseErrors returns the errors of a single or batched JSON-RPC response body.
+//
+// @param body The response body
+// @return The message and code of each error, or nil if there are none or the body cannot be decoded
+func responseErrors(body string) []string {
+	messages, _, err := decodeMessages([]byte(body))
+	if err != nil {
+		return nil
+	}
+
+	var failures []string
+	for _, message := range messages {
+		raw, ok := message["error"]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+
+		var rpcErr struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if err = json.Unmarshal(raw, &rpcErr); err != nil {
+			failures = append(failures, string(raw))
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s (code %d)", rpcErr.Message, rpcErr.Code))
+	}
+	return failures
 }
 
 // parseRequestBody reads the request body and returns it as a string.
@@ -85,3 +228,45 @@ func parseRequestBody(req *http.Request) string {
 
 	return string(reqBody)
 }
+
+// errorPeekLimit is the number of bytes of each response body that are read to check for JSON-RPC errors when only
+// failed requests are logged. JSON-RPC error responses are short, so a body that is truncated at the limit cannot be
+// decoded, and is treated as a successful result unless the HTTP status is an error.
+const errorPeekLimit = 4096
+
+// truncatedSuffix is appended to logged response bodies that were truncated by MaxBodyLog
+const truncatedSuffix = "... (truncated)"
+
+// peekResponseBody reads the response body, or at most limit bytes of it, and returns it as a string. The body is reset
+// so the full body can be read again by subsequent handlers, and only the bytes that were read are buffered.
+//
+// @param resp The HTTP response containing the body to read
+// @param limit The maximum number of bytes to read, or 0 to read the whole body
+// @return The body as a string, which ends with a truncation notice if it was longer than the limit
+// @return Empty string and error if reading the body fails
+func peekResponseBody(resp *http.Response, limit int) (string, error) {
+	if limit <= 0 {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", err
+		}
+		resp.Body = io.NopCloser(bytes.NewBuffer(body))
+		return string(body), nil
+	}
+
+	// Read one byte past the limit to find out whether the body is truncated
+	prefix, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)+1))
+	if err != nil {
+		return "", err
+	}
+
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+
+	if len(prefix) > limit {
+		return string(prefix[:limit]) + truncatedSuffix, nil
+	}
+	return string(prefix), nil
+}
@@ -0,0 +1,149 @@
+// Package transport provides HTTP transport mechanisms for the Radius SDK.
+// It includes interceptors and middleware for logging, debugging, and modifying
+// JSON-RPC requests and responses.
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// rewriteRequestIDs replaces the IDs of the JSON-RPC requests in the request body with IDs from the generator. Since
+// the JSON-RPC client matches batch responses to requests by ID, the original IDs of a batch must be restored in the
+// response with restoreResponseIDs. The response to a single request is not matched by ID, so it is left as is and
+// streamed to the client, instead of being read into memory to restore the ID.
+//
+// @param req The HTTP request containing a single or batched JSON-RPC request
+// @param generate The function used to generate the new request IDs
+// @return The rewritten request, the original IDs of a batch keyed by the generated IDs (nil for a single request),
+// and nil error on success
+// @return nil, nil, and error if the request body is not a JSON-RPC request
+func rewriteRequestIDs(req *http.Request, generate RequestIDFunc) (*http.Request, map[string]json.RawMessage, error) {
+	if req.Body == nil {
+		return req, nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+
+	messages, batch, err := decodeMessages(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode JSON-RPC request: %w", err)
+	}
+
+	ids := make(map[string]json.RawMessage, len(messages))
+	for _, message := range messages {
+		original, ok := message["id"]
+		if !ok {
+			// Notifications have no ID, and receive no response
+			continue
+		}
+
+		id := generate()
+		if _, exists := ids[id]; exists {
+			return nil, nil, fmt.Errorf("duplicate JSON-RPC request ID: %s", id)
+		}
+		ids[id] = original
+
+		if message["id"], err = json.Marshal(id); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	body, err = encodeMessages(messages, batch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rewritten := req.Clone(req.Context())
+	rewritten.Body = io.NopCloser(bytes.NewReader(body))
+	rewritten.ContentLength = int64(len(body))
+	rewritten.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	if !batch {
+		return rewritten, nil, nil
+	}
+	return rewritten, ids, nil
+}
+
+// restoreResponseIDs replaces the generated IDs of the JSON-RPC responses in a batch response body with the original
+// IDs of the requests. The whole body is read, so it is only used for batches.
+//
+// @param resp The HTTP response containing a batched JSON-RPC response
+// @param ids The original request IDs keyed by the generated IDs
+// @return nil error on success, or error if the response body cannot be read
+func restoreResponseIDs(resp *http.Response, ids map[string]json.RawMessage) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	messages, batch, err := decodeMessages(body)
+	if err != nil {
+		// Leave responses that are not JSON-RPC (e.g. HTTP errors) as is
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return nil
+	}
+
+	for _, message := range messages {
+		var id string
+		if json.Unmarshal(message["id"], &id) != nil {
+			continue
+		}
+		if original, ok := ids[id]; ok {
+			message["id"] = original
+		}
+	}
+
+	if body, err = encodeMessages(messages, batch); err != nil {
+		return err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+
+	return nil
+}
+
+// decodeMessages decodes a single or batched JSON-RPC message body.
+//
+// @param body The JSON-RPC message body
+// @return The decoded messages, whether the body is a batch, and nil error on success
+// @return nil, false, and error if the body is not a JSON object or array of objects
+func decodeMessages(body []byte) ([]map[string]json.RawMessage, bool, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var messages []map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &messages); err != nil {
+			return nil, false, err
+		}
+		return messages, true, nil
+	}
+
+	var message map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &message); err != nil {
+		return nil, false, err
+	}
+	return []map[string]json.RawMessage{message}, false, nil
+}
+
+// encodeMessages encodes JSON-RPC messages as a single or batched message body.
+//
+// @param messages The JSON-RPC messages to encode
+// @param batch Whether to encode the messages as a batch
+// @return The encoded message body and nil error on success
+// @return nil and error if the messages cannot be encoded
+func encodeMessages(messages []map[string]json.RawMessage, batch bool) ([]byte, error) {
+	if batch {
+		return json.Marshal(messages)
+	}
+	return json.Marshal(messages[0])
+}
@@ -12,6 +12,19 @@ import "net/http"
 // @param args The values to substitute into the format string
 type Logf func(format string, args ...any)
 
+// LogLevel controls which requests and responses are logged by an InterceptingRoundTripper.
+type LogLevel int
+
+const (
+	// LogAll logs every request and response in full, which is the default
+	LogAll LogLevel = iota
+
+	// LogErrorsOnly logs only failed requests, with the JSON-RPC methods, HTTP status, and error, but without the
+	// request body, which may contain sensitive data such as signed transactions. Only the start of each response body
+	// is read to check for JSON-RPC errors.
+	LogErrorsOnly
+)
+
 // Interceptor is a function interface used to intercept and modify HTTP requests and responses.
 // This allows for custom handling, validation, or manipulation of JSON-RPC calls.
 //
@@ -20,3 +33,17 @@ type Logf func(format string, args ...any)
 // @return A potentially modified response or the original response
 // @return An error if interceptor processing fails
 type Interceptor func(reqBody string, resp *http.Response) (*http.Response, error)
+
+// RequestInterceptor is a function interface used to inspect or modify outgoing HTTP requests before they are sent.
+// This allows for custom handling of JSON-RPC calls, such as adding headers or rewriting request parameters.
+//
+// @param req The outgoing HTTP request to the JSON-RPC server
+// @return A potentially modified request or the original request
+// @return An error if interceptor processing fails, which aborts the request
+type RequestInterceptor func(req *http.Request) (*http.Request, error)
+
+// RequestIDFunc is a function interface used to generate the IDs of outgoing JSON-RPC requests, e.g. to correlate
+// requests across logs of the client, proxies, and the server. Each call should return a new, unique ID.
+//
+// @return The ID of the next JSON-RPC request
+type RequestIDFunc func() string
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/accesstoken"
+)
+
+// mockTrue is the ABI encoding of a boolean true return value
+const mockTrue = "0x0000000000000000000000000000000000000000000000000000000000000001"
+
+func TestAccessTokenSystem_Transactions(t *testing.T) {
+	abi := accesstoken.ABI()
+	require.NotNil(t, abi, "Failed to parse embedded ABI")
+
+	tierIDs := []*big.Int{big.NewInt(1), big.NewInt(2)}
+
+	tests := []struct {
+		name      string
+		send      func(*accesstoken.AccessTokenSystem, *radius.Client, radius.Signer) (*radius.Receipt, error)
+		method    string
+		args      []interface{}
+		wantValue *big.Int
+	}{
+		{
+			name: "CreateTier",
+			send: func(a *accesstoken.AccessTokenSystem, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return a.CreateTier(context.Background(), c, s, big.NewInt(1), big.NewInt(100), big.NewInt(3600), true)
+			},
+			method:    "createTier",
+			args:      []interface{}{big.NewInt(1), big.NewInt(100), big.NewInt(3600), true},
+			wantValue: big.NewInt(0),
+		},
+		{
+			name: "PurchaseAccess",
+			send: func(a *accesstoken.AccessTokenSystem, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return a.PurchaseAccess(context.Background(), c, s, big.NewInt(1), big.NewInt(100))
+			},
+			method:    "purchaseAccess",
+			args:      []interface{}{big.NewInt(1)},
+			wantValue: big.NewInt(100),
+		},
+		{
+			name: "BatchPurchase",
+			send: func(a *accesstoken.AccessTokenSystem, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return a.BatchPurchase(context.Background(), c, s, tierIDs, big.NewInt(200))
+			},
+			method:    "batchPurchaseAccess",
+			args:      []interface{}{tierIDs},
+			wantValue: big.NewInt(200),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, sent, _ := sendMockTransaction(t, accesstoken.New, tt.send)
+
+			want, err := abi.Pack(tt.method, tt.args...)
+			require.NoError(t, err, "Failed to pack method call")
+
+			assert.Equal(t, want, sent.Data(), "Unexpected method encoding")
+			assert.Equal(t, tt.wantValue, sent.Value(), "Unexpected value")
+			assert.Equal(t, MockContractAddress, hexAddress(sent.To().Bytes()), "Unexpected contract address")
+		})
+	}
+}
+
+func TestAccessTokenSystem_Calls(t *testing.T) {
+	abi := accesstoken.ABI()
+	user := CreateTestAccount(t, NewMockServer(t).NewClient(t)).Address()
+	signature := make([]byte, 65)
+
+	tests := []struct {
+		name   string
+		call   func(*accesstoken.AccessTokenSystem, *radius.Client) (bool, error)
+		method string
+		args   []interface{}
+	}{
+		{
+			name: "IsValid",
+			call: func(a *accesstoken.AccessTokenSystem, c *radius.Client) (bool, error) {
+				return a.IsValid(context.Background(), c, user, big.NewInt(1))
+			},
+			method: "isValid",
+			args:   []interface{}{user.EthAddress(), big.NewInt(1)},
+		},
+		{
+			name: "VerifyAccess",
+			call: func(a *accesstoken.AccessTokenSystem, c *radius.Client) (bool, error) {
+				return a.VerifyAccess(context.Background(), c, big.NewInt(1), "challenge", signature)
+			},
+			method: "verifyAccess",
+			args:   []interface{}{big.NewInt(1), "challenge", signature},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			server.HandleResult("eth_call", mockTrue)
+			client := server.NewClient(t)
+			address, err := radius.AddressFromHex(MockContractAddress)
+			require.NoError(t, err, "Failed to parse contract address")
+
+			valid, err := tt.call(accesstoken.New(address), client)
+			require.NoError(t, err, "Failed to call contract")
+			assert.True(t, valid, "Unexpected result")
+
+			want, err := abi.Pack(tt.method, tt.args...)
+			require.NoError(t, err, "Failed to pack method call")
+
+			requests := server.Requests("eth_call")
+			require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+
+			var msg MockCallArg
+			requests[0].Param(t, 0, &msg)
+			assert.Equal(t, "0x"+hex.EncodeToString(want), msg.Input, "Unexpected method encoding")
+		})
+	}
+}
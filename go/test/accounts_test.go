@@ -0,0 +1,316 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/testutil"
+)
+
+func TestAccount_NonceAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		blockTag string
+		want     uint64
+	}{
+		{name: "latest", blockTag: radius.BlockTagLatest, want: 3},
+		{name: "pending", blockTag: radius.BlockTagPending, want: 5},
+		{name: "finalized", blockTag: radius.BlockTagFinalized, want: 3},
+		{name: "block number", blockTag: "0x1b4", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.Handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+				var tag string
+				if err := json.Unmarshal(params[1], &tag); err != nil {
+					return nil, err
+				}
+				if tag == radius.BlockTagPending {
+					return "0x5", nil
+				}
+				return "0x3", nil
+			})
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+
+			nonce, err := account.NonceAtWith(context.Background(), client, tt.blockTag)
+			require.NoError(t, err, "Failed to get nonce")
+			assert.Equal(t, tt.want, nonce, "Unexpected nonce")
+
+			requests := server.Requests("eth_getTransactionCount")
+			require.Len(t, requests, 1, "Unexpected number of eth_getTransactionCount requests")
+
+			var address, tag string
+			requests[0].Param(t, 0, &address)
+			requests[0].Param(t, 1, &tag)
+			from := account.Address()
+			assert.Equal(t, hexAddress(from.Bytes()), address, "Unexpected address")
+			assert.Equal(t, tt.blockTag, tag, "Unexpected block tag")
+		})
+	}
+
+	for _, blockTag := range []string{"", "Latest", "head", "436", "0x", "0xzz"} {
+		t.Run("invalid "+blockTag, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleResult("eth_getTransactionCount", "0x3")
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+
+			_, err := account.NonceAtWith(context.Background(), client, blockTag)
+			assert.ErrorContains(t, err, "invalid block tag", "Invalid block tags should be rejected")
+			assert.Empty(t, server.Requests("eth_getTransactionCount"), "Invalid block tags should not be sent")
+		})
+	}
+}
+
+func TestAccount_CanAfford(t *testing.T) {
+	tests := []struct {
+		name          string
+		reserve       *big.Int
+		value         *big.Int
+		wantOK        bool
+		wantShortfall *big.Int
+	}{
+		{name: "affordable", value: big.NewInt(100), wantOK: true, wantShortfall: big.NewInt(0)},
+		{name: "value above balance", value: big.NewInt(150), wantOK: false, wantShortfall: big.NewInt(50)},
+		{name: "gas reserve above remainder", reserve: big.NewInt(10), value: big.NewInt(95), wantOK: false, wantShortfall: big.NewInt(5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleResult("eth_getBalance", "0x64") // 100 wei
+			client := server.NewClient(t)
+
+			key, err := crypto.GenerateKey()
+			require.NoError(t, err, "Failed to generate private key")
+
+			opts := []radius.AccountOption{radius.WithPrivateKey(key, client)}
+			if tt.reserve != nil {
+				opts = append(opts, radius.WithGasReserve(tt.reserve))
+			}
+			account := radius.NewAccount(opts...)
+
+			ok, shortfall, err := account.CanAffordWith(context.Background(), client, tt.value)
+			require.NoError(t, err, "Failed to check affordability")
+			assert.Equal(t, tt.wantOK, ok, "Unexpected affordability")
+			assert.Equal(t, tt.wantShortfall, shortfall, "Unexpected shortfall")
+		})
+	}
+}
+
+func TestAccount_BalanceEther(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getBalance", "0x14d1120d7b160000") // 1.5 ether
+	client := server.NewClient(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithPrivateKey(key, client))
+
+	balance, err := account.BalanceEtherWith(context.Background(), client)
+	require.NoError(t, err, "Failed to get balance")
+	assert.Equal(t, "1.5", balance, "Unexpected balance")
+
+	balance, err = client.BalanceEtherAt(context.Background(), account.Address())
+	require.NoError(t, err, "Failed to get balance")
+	assert.Equal(t, "1.5", balance, "Unexpected balance")
+}
+
+func TestClient_NativeDecimals(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getBalance", "0x1312d0") // 1.25 with 6 decimals
+	client := server.NewClient(t, radius.WithNativeDecimals(6))
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithPrivateKey(key, client))
+
+	assert.Equal(t, 6, client.NativeDecimals(), "Unexpected native decimals")
+	assert.Equal(t, "1.5", client.FormatNative(big.NewInt(1500000)), "Amounts should be formatted with 6 decimals")
+
+	balance, err := account.BalanceEtherWith(context.Background(), client)
+	require.NoError(t, err, "Failed to get balance")
+	assert.Equal(t, "1.25", balance, "Balances should be formatted with 6 decimals")
+
+	assert.Equal(t, radius.EtherDecimals, server.NewClient(t).NativeDecimals(), "The default should be 18 decimals")
+
+	_, err = radius.NewClient(server.URL, radius.WithNativeDecimals(-1))
+	assert.ErrorContains(t, err, "native decimals must not be negative", "Negative decimals should be rejected")
+}
+
+func TestAccount_SendWithBalanceCheck(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_getBalance", "0x64") // 100 wei
+	client := server.NewClient(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithPrivateKey(key, client), radius.WithBalanceCheck())
+
+	_, err = account.SendWith(context.Background(), client, recipient, big.NewInt(150))
+	assert.ErrorContains(t, err, "insufficient balance: short by 50 wei", "Unaffordable transfers should be rejected")
+	assert.Empty(t, server.SentTransactions(), "Unaffordable transfers should not be broadcast")
+
+	receipt, err := account.SendWith(context.Background(), client, recipient, big.NewInt(100))
+	require.NoError(t, err, "Affordable transfers should be sent")
+	assert.True(t, receipt.Succeeded(), "Transaction should succeed")
+	assert.Len(t, server.SentTransactions(), 1, "Unexpected number of transactions")
+}
+
+func TestAccount_Execute(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithPrivateKey(key, client))
+
+	contract := newMockContract(t, SimpleStorageABI)
+	receipt, err := account.ExecuteWith(context.Background(), client, contract, "set", big.NewInt(42))
+	require.NoError(t, err, "Failed to execute method")
+	assert.True(t, receipt.Succeeded(), "Transaction should succeed")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	sender, err := types.Sender(types.LatestSignerForChainID(sent[0].ChainId()), sent[0])
+	require.NoError(t, err, "Failed to recover sender")
+	from := account.Address()
+	assert.Equal(t, from.Bytes(), sender.Bytes(), "Transaction should be signed by the account")
+
+	_, data, err := contract.EncodeCall("set", big.NewInt(42))
+	require.NoError(t, err, "Failed to encode call")
+	assert.Equal(t, data, sent[0].Data(), "Unexpected method encoding")
+	to := contract.Address()
+	assert.Equal(t, to.Bytes(), sent[0].To().Bytes(), "Unexpected recipient")
+
+	_, err = radius.NewAccount().ExecuteWith(context.Background(), client, contract, "set", big.NewInt(42))
+	assert.ErrorContains(t, err, "signer is required", "Accounts without a signer should not execute methods")
+}
+
+func TestAccount_WithClient(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	bound := NewMockServer(t)
+	bound.HandleTransactions()
+	bound.HandleResult("eth_getBalance", "0x64") // 100 wei
+	boundClient := bound.NewClient(t)
+
+	explicit := NewMockServer(t)
+	explicit.HandleTransactions()
+	explicit.HandleResult("eth_getBalance", "0xc8") // 200 wei
+	explicitClient := explicit.NewClient(t)
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithPrivateKey(key, boundClient), radius.WithClient(boundClient))
+	ctx := context.Background()
+
+	balance, err := account.Balance(ctx)
+	require.NoError(t, err, "Failed to get balance with the bound client")
+	assert.Equal(t, big.NewInt(100), balance, "Balance should be queried with the bound client")
+
+	balance, err = account.BalanceWith(ctx, explicitClient)
+	require.NoError(t, err, "Failed to get balance with the explicit client")
+	assert.Equal(t, big.NewInt(200), balance, "An explicit client should take precedence")
+
+	_, err = account.Nonce(ctx)
+	require.NoError(t, err, "Failed to get nonce with the bound client")
+
+	receipt, err := account.Send(ctx, recipient, big.NewInt(100))
+	require.NoError(t, err, "Failed to send with the bound client")
+	assert.True(t, receipt.Succeeded(), "Transaction should succeed")
+
+	receipt, err = account.Execute(ctx, newMockContract(t, SimpleStorageABI), "set", big.NewInt(42))
+	require.NoError(t, err, "Failed to execute method with the bound client")
+	assert.True(t, receipt.Succeeded(), "Transaction should succeed")
+	assert.Len(t, bound.SentTransactions(), 2, "Transactions should be sent with the bound client")
+	assert.Empty(t, explicit.SentTransactions(), "No transactions should be sent with the explicit client")
+
+	_, err = radius.NewAccount(radius.WithPrivateKey(key, boundClient)).Balance(ctx)
+	assert.ErrorContains(t, err, "client is required", "Accounts without a bound client should require an explicit client")
+	_, err = account.BalanceWith(ctx, nil)
+	assert.ErrorContains(t, err, "client is required", "The With variants should not fall back to the bound client")
+}
+
+func TestAccount_SignAndSerialize(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	// The cold wallet signs without a client, so the chain ID is given
+	cold := radius.NewAccount(radius.WithSigner(radius.NewKeySignerWithChainID(key, big.NewInt(1234))))
+
+	_, err = cold.SignAndSerialize(radius.NewTransaction(3, &recipient, big.NewInt(100), 0, big.NewInt(1), nil))
+	assert.ErrorContains(t, err, "gas limit is required", "Incomplete transactions should be rejected")
+	_, err = radius.NewAccount().SignAndSerialize(radius.NewTransaction(3, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil))
+	assert.ErrorContains(t, err, "signer is required", "Accounts without a signer should be rejected")
+
+	raw, err := cold.SignAndSerialize(radius.NewTransaction(3, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil))
+	require.NoError(t, err, "Failed to sign transaction offline")
+	dynamic, err := cold.SignAndSerialize(&radius.Transaction{
+		Nonce: 4, To: &recipient, Value: big.NewInt(100), Gas: 21000, GasFeeCap: big.NewInt(2), GasTipCap: big.NewInt(1),
+	})
+	require.NoError(t, err, "Failed to sign dynamic fee transaction offline")
+
+	// The bytes are broadcast from another machine
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	for _, bytes := range [][]byte{raw, dynamic} {
+		hash, err := client.SendRawTransaction(context.Background(), bytes)
+		require.NoError(t, err, "Failed to send raw transaction")
+		receipt, err := client.WaitForReceipt(context.Background(), hash)
+		require.NoError(t, err, "Failed to wait for receipt")
+		assert.Equal(t, cold.Address(), receipt.From, "Unexpected sender")
+	}
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 2, "Unexpected number of transactions")
+	assert.Equal(t, uint64(3), sent[0].Nonce(), "Unexpected nonce")
+	assert.Equal(t, uint8(types.DynamicFeeTxType), sent[1].Type(), "Unexpected transaction type")
+}
+
+func TestCreateFundedAccount(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_getBalance", "0x3e8")
+	client := server.NewClient(t)
+	funder := CreateTestAccount(t, client)
+
+	account, key, err := testutil.CreateFundedAccount(context.Background(), client, funder.Signer, big.NewInt(1000))
+	require.NoError(t, err, "Failed to create funded account")
+	assert.NotEqual(t, funder.Address(), account.Address(), "A new account should be created")
+	assert.Equal(t, radius.AddressFromPublicKey(&key.PublicKey), account.Address(), "The key of the account should be returned")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	to := account.Address()
+	assert.Equal(t, to.Bytes(), sent[0].To().Bytes(), "The funds should be sent to the new account")
+	assert.Equal(t, big.NewInt(1000), sent[0].Value(), "Unexpected amount")
+	sender, err := types.Sender(types.LatestSignerForChainID(sent[0].ChainId()), sent[0])
+	require.NoError(t, err, "Failed to recover sender")
+	from := funder.Address()
+	assert.Equal(t, from.Bytes(), sender.Bytes(), "The funds should be sent from the funder")
+
+	balance, err := account.Balance(context.Background())
+	require.NoError(t, err, "The account should be bound to the client")
+	assert.Equal(t, big.NewInt(1000), balance, "Unexpected balance")
+}
@@ -0,0 +1,1744 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// fakeResolver resolves names using a static lookup table
+type fakeResolver map[string]radius.Address
+
+// Resolve implements the Resolver interface
+func (r fakeResolver) Resolve(_ context.Context, name string) (radius.Address, error) {
+	address, ok := r[name]
+	if !ok {
+		return radius.ZeroAddress(), fmt.Errorf("unknown name")
+	}
+	return address, nil
+}
+
+func TestClient_SendToName(t *testing.T) {
+	ctx := context.Background()
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t, radius.WithResolver(fakeResolver{"alice.radius": recipient}))
+	account := CreateTestAccount(t, client)
+
+	receipt, err := client.SendToName(ctx, account.Signer, "alice.radius", big.NewInt(100))
+	require.NoError(t, err, "Failed to send to name")
+	assert.Equal(t, recipient, receipt.To, "Unexpected recipient address")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, MockContractAddress, hexAddress(sent[0].To().Bytes()), "Transaction should be sent to the resolved address")
+	assert.Equal(t, big.NewInt(100), sent[0].Value(), "Unexpected value")
+
+	_, err = client.SendToName(ctx, account.Signer, "bob.radius", big.NewInt(100))
+	assert.Error(t, err, "Unknown names should not resolve")
+
+	resolved, err := client.Resolve(ctx, MockContractAddress)
+	require.NoError(t, err, "Hex addresses should resolve without a lookup")
+	assert.Equal(t, recipient, resolved, "Unexpected resolved address")
+}
+
+func TestClient_AutoReplace(t *testing.T) {
+	ctx := context.Background()
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+
+	attempts := 0
+	accept := server.Handler("eth_sendRawTransaction")
+	server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, &MockError{Code: -32000, Message: "replacement transaction underpriced"}
+		}
+		return accept(params)
+	})
+
+	client := server.NewClient(t, radius.WithAutoReplace(10))
+	account := CreateTestAccount(t, client)
+
+	receipt, err := client.Send(ctx, account.Signer, recipient, big.NewInt(100))
+	require.NoError(t, err, "Underpriced transaction should be replaced")
+	require.NotNil(t, receipt, "Receipt should not be nil")
+	assert.Equal(t, 2, attempts, "Unexpected number of send attempts")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Only the replacement should be accepted")
+	assert.Equal(t, uint64(0), sent[0].Nonce(), "Replacement should reuse the nonce")
+	assert.Equal(t, big.NewInt(1), sent[0].GasPrice(), "Replacement should bump the gas price")
+	assert.Equal(t, sent[0].Hash().Bytes(), receipt.TxHash.Bytes(), "Receipt should be for the replacement")
+}
+
+func TestClient_AutoReplaceDynamicFee(t *testing.T) {
+	ctx := context.Background()
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+
+	attempts := 0
+	accept := server.Handler("eth_sendRawTransaction")
+	server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, &MockError{Code: -32000, Message: "replacement transaction underpriced"}
+		}
+		return accept(params)
+	})
+
+	client := server.NewClient(t, radius.WithAutoReplace(10))
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234), radius.WithSignerType(radius.SignerTypeLondon))
+
+	tx, err := client.PrepareTxWithOptions(ctx, signer, &recipient, nil, big.NewInt(100), radius.TxOptions{
+		Gas:       radius.TransferGas,
+		GasFeeCap: big.NewInt(1000),
+		GasTipCap: big.NewInt(100),
+	})
+	require.NoError(t, err, "Failed to prepare transaction")
+	signed, err := signer.SignTransaction(tx)
+	require.NoError(t, err, "Failed to sign transaction")
+
+	_, err = client.Transact(ctx, signer, signed)
+	require.NoError(t, err, "Underpriced transaction should be replaced")
+	assert.Equal(t, 2, attempts, "Unexpected number of send attempts")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Only the replacement should be accepted")
+	assert.Equal(t, uint8(types.DynamicFeeTxType), sent[0].Type(), "Replacement should keep the transaction type")
+	assert.Equal(t, big.NewInt(1100), sent[0].GasFeeCap(), "Replacement should bump the fee cap")
+	assert.Equal(t, big.NewInt(110), sent[0].GasTipCap(), "Replacement should bump the tip cap")
+}
+
+func TestClient_AutoReplaceDisabled(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.Handle("eth_sendRawTransaction", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "replacement transaction underpriced"}
+	})
+
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	_, err = client.Send(context.Background(), account.Signer, recipient, big.NewInt(100))
+	assert.ErrorContains(t, err, "replacement transaction underpriced", "Error should be surfaced without WithAutoReplace")
+	assert.Len(t, server.Requests("eth_sendRawTransaction"), 1, "Transaction should not be resent")
+}
+
+func TestClient_PrepareTxWithOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts radius.TxOptions
+		want uint64
+	}{
+		{name: "default margin", opts: radius.TxOptions{}, want: 25200},
+		{name: "skip margin", opts: radius.TxOptions{SkipGasMargin: true}, want: 21000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipient, err := radius.AddressFromHex(MockContractAddress)
+			require.NoError(t, err, "Failed to parse recipient address")
+
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+
+			tx, err := client.PrepareTxWithOptions(context.Background(), account.Signer, &recipient, []byte{0x01}, big.NewInt(100), tt.opts)
+			require.NoError(t, err, "Failed to prepare transaction")
+			assert.Equal(t, tt.want, tx.Gas, "Unexpected gas limit")
+			assert.Equal(t, big.NewInt(100), tx.Value, "Unexpected value")
+		})
+	}
+}
+
+func TestClient_GasOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []radius.ClientOption
+		want uint64
+	}{
+		{name: "default margin", want: 25200},
+		{name: "custom margin", opts: []radius.ClientOption{radius.WithGasMargin(50)}, want: 31500},
+		{name: "no margin", opts: []radius.ClientOption{radius.WithGasMargin(0)}, want: 21000},
+		{name: "max gas", opts: []radius.ClientOption{radius.WithMaxGas(22000)}, want: 22000},
+		{name: "max gas above estimate", opts: []radius.ClientOption{radius.WithMaxGas(30000)}, want: 25200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipient, err := radius.AddressFromHex(MockContractAddress)
+			require.NoError(t, err, "Failed to parse recipient address")
+
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			client := server.NewClient(t, tt.opts...)
+			account := CreateTestAccount(t, client)
+
+			gas, err := client.EstimateGas(context.Background(), &radius.Transaction{To: &recipient, Value: big.NewInt(100)})
+			require.NoError(t, err, "Failed to estimate gas")
+			assert.Equal(t, tt.want, gas, "Unexpected gas estimate")
+
+			tx, err := client.PrepareTx(context.Background(), account.Signer, &recipient, []byte{0x01}, big.NewInt(100))
+			require.NoError(t, err, "Failed to prepare transaction")
+			assert.Equal(t, tt.want, tx.Gas, "Unexpected gas limit")
+		})
+	}
+}
+
+func TestClient_TransferGas(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	tests := []struct {
+		name     string
+		opts     []radius.ClientOption
+		want     uint64
+		estimate bool
+	}{
+		{name: "plain transfer", want: radius.TransferGas},
+		{name: "estimation enabled", opts: []radius.ClientOption{radius.WithTransferGasEstimation()}, want: 54000, estimate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			server.HandleResult("eth_getCode", "0x6080")
+			server.HandleResult("eth_estimateGas", "0xafc8") // 45000
+			client := server.NewClient(t, tt.opts...)
+			account := CreateTestAccount(t, client)
+
+			_, err := client.Send(context.Background(), account.Signer, recipient, big.NewInt(100))
+			require.NoError(t, err, "Failed to send value")
+			assert.Empty(t, server.Requests("eth_getCode"), "The code of the recipient should not be requested")
+			if tt.estimate {
+				assert.Len(t, server.Requests("eth_estimateGas"), 1, "Gas should be estimated")
+			} else {
+				assert.Empty(t, server.Requests("eth_estimateGas"), "Gas estimation should be skipped for plain transfers")
+			}
+
+			sent := server.SentTransactions()
+			require.Len(t, sent, 1, "Unexpected number of transactions")
+			assert.Equal(t, tt.want, sent[0].Gas(), "Unexpected gas limit")
+		})
+	}
+}
+
+func TestClient_WaitForNonce(t *testing.T) {
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+
+	// Each poll mines one more transaction of the account
+	var nonce atomic.Uint64
+	server := NewMockServer(t)
+	server.Handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(nonce.Add(1) - 1), nil
+	})
+	client := server.NewClient(t, radius.WithPollInterval(10*time.Millisecond))
+
+	require.NoError(t, client.WaitForNonce(context.Background(), address, 3), "Failed to wait for nonce")
+	requests := server.Requests("eth_getTransactionCount")
+	assert.Len(t, requests, 4, "The nonce should be polled until it reaches the target")
+	var tag string
+	requests[0].Param(t, 1, &tag)
+	assert.Equal(t, radius.BlockTagLatest, tag, "The latest nonce should be polled")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err = client.WaitForNonce(ctx, address, 1000)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "Waiting should stop when the context is done")
+}
+
+func TestClient_NonceManagerResync(t *testing.T) {
+	ctx := context.Background()
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+
+	// nodeNonce is the next nonce expected by the node
+	var nodeNonce atomic.Uint64
+	server.Handle("eth_getTransactionCount", func([]json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(nodeNonce.Load()), nil
+	})
+	accept := server.Handler("eth_sendRawTransaction")
+	server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var raw hexutil.Bytes
+		if err := json.Unmarshal(params[0], &raw); err != nil {
+			return nil, err
+		}
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return nil, err
+		}
+		if tx.Nonce() < nodeNonce.Load() {
+			return nil, &MockError{Code: -32000, Message: fmt.Sprintf("nonce too low: next nonce %d, tx nonce %d", nodeNonce.Load(), tx.Nonce())}
+		}
+		nodeNonce.Store(tx.Nonce() + 1)
+		return accept(params)
+	})
+
+	client := server.NewClient(t, radius.WithNonceManager(radius.NewNonceManager()))
+	account := CreateTestAccount(t, client)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Send(ctx, account.Signer, recipient, big.NewInt(100))
+		require.NoError(t, err, "Failed to send transaction")
+	}
+	assert.Len(t, server.Requests("eth_getTransactionCount"), 1, "Nonce should be tracked locally after the first fetch")
+
+	// Another sender using the same key advances the nonce on the node, so the tracked nonce is now stale
+	nodeNonce.Store(5)
+
+	receipt, err := client.Send(ctx, account.Signer, recipient, big.NewInt(100))
+	require.NoError(t, err, "Transaction should be resent after resyncing the nonce")
+	require.NotNil(t, receipt, "Receipt should not be nil")
+	assert.Len(t, server.Requests("eth_sendRawTransaction"), 4, "Stale transaction should be resent once")
+	assert.Len(t, server.Requests("eth_getTransactionCount"), 2, "Nonce should be resynced from the node")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 3, "Unexpected number of accepted transactions")
+	nonces := []uint64{sent[0].Nonce(), sent[1].Nonce(), sent[2].Nonce()}
+	assert.Equal(t, []uint64{0, 1, 5}, nonces, "Unexpected transaction nonces")
+	assert.Equal(t, sent[2].Hash().Bytes(), receipt.TxHash.Bytes(), "Receipt should be for the resent transaction")
+}
+
+func TestClient_NonceTooLowWithoutNonceManager(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.Handle("eth_sendRawTransaction", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "nonce too low"}
+	})
+
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	_, err = client.Send(context.Background(), account.Signer, recipient, big.NewInt(100))
+	assert.ErrorContains(t, err, "nonce too low", "Error should be surfaced without WithNonceManager")
+	assert.Len(t, server.Requests("eth_sendRawTransaction"), 1, "Transaction should not be resent")
+}
+
+func TestClient_NonceManagerFailedPrepare(t *testing.T) {
+	ctx := context.Background()
+	server := NewMockServer(t)
+	server.HandleTransactions()
+
+	estimates := 0
+	estimate := server.Handler("eth_estimateGas")
+	server.Handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		estimates++
+		if estimates == 1 {
+			return nil, &MockError{Code: -32000, Message: "internal error"}
+		}
+		return estimate(params)
+	})
+
+	client := server.NewClient(t, radius.WithNonceManager(radius.NewNonceManager()))
+	account := CreateTestAccount(t, client)
+	contract := newMockContract(t, SimpleStorageABI)
+	selector := radius.FunctionSelector("get()")
+
+	_, err := contract.ExecuteRaw(ctx, client, account.Signer, selector, nil)
+	require.ErrorContains(t, err, "failed to estimate gas", "Gas estimation should fail")
+
+	// Preparing a transaction without sending it must not reserve a nonce either
+	tx, err := client.PrepareTx(ctx, account.Signer, nil, []byte{0x01}, nil)
+	require.NoError(t, err, "Failed to prepare transaction")
+	assert.Equal(t, uint64(0), tx.Nonce, "Prepared transaction should use the next nonce")
+
+	_, err = contract.ExecuteRaw(ctx, client, account.Signer, selector, nil)
+	require.NoError(t, err, "Failed to execute after a failed estimation")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, uint64(0), sent[0].Nonce(), "The nonce of the failed transaction should be reused")
+}
+
+func TestClient_PendingTransactionError(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+
+	// The node accepts the transaction, but does not mine it until mined is set
+	var mined atomic.Bool
+	receipt := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		if !mined.Load() {
+			return nil, nil
+		}
+		return receipt(params)
+	})
+
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Send(ctx, account.Signer, recipient, big.NewInt(100))
+	require.Error(t, err, "Waiting for the receipt should time out")
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "Error should wrap the deadline error")
+
+	var pending *radius.PendingTransactionError
+	require.ErrorAs(t, err, &pending, "Error should carry the pending transaction")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, sent[0].Hash().Bytes(), pending.Hash.Bytes(), "Error should carry the sent transaction hash")
+
+	mined.Store(true)
+	resumed, err := client.WaitForReceipt(context.Background(), pending.Hash)
+	require.NoError(t, err, "Failed to resume waiting for the receipt")
+	assert.True(t, resumed.Succeeded(), "Transaction should succeed")
+	assert.Equal(t, sent[0].Hash().Bytes(), resumed.TxHash.Bytes(), "Unexpected receipt transaction hash")
+	assert.Equal(t, account.Address(), resumed.From, "Unexpected sender")
+	assert.Equal(t, recipient, resumed.To, "Unexpected recipient")
+	assert.Equal(t, big.NewInt(100), resumed.Value, "Unexpected value")
+}
+
+func TestClient_RequestInterceptor(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getBalance", "0x64")
+
+	// Rewrite the block parameter of every request from "latest" to block 1
+	rewrite := func(req *http.Request) (*http.Request, error) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.ReplaceAll(body, []byte(`"latest"`), []byte(`"0x1"`))
+
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+		return req, nil
+	}
+
+	client := server.NewClient(t, radius.WithRequestInterceptor(rewrite))
+	account := CreateTestAccount(t, client)
+
+	balance, err := account.BalanceWith(context.Background(), client)
+	require.NoError(t, err, "Failed to get balance")
+	assert.Equal(t, big.NewInt(100), balance, "Unexpected balance")
+
+	requests := server.Requests("eth_getBalance")
+	require.Len(t, requests, 1, "Unexpected number of eth_getBalance requests")
+
+	var block string
+	requests[0].Param(t, 1, &block)
+	assert.Equal(t, "0x1", block, "Server should receive the rewritten request")
+}
+
+func TestClient_RequestInterceptorError(t *testing.T) {
+	server := NewMockServer(t)
+	client := server.NewClient(t, radius.WithRequestInterceptor(func(*http.Request) (*http.Request, error) {
+		return nil, fmt.Errorf("request blocked")
+	}))
+
+	_, err := client.ChainID(context.Background())
+	assert.ErrorContains(t, err, "request blocked", "Interceptor errors should abort the request")
+	assert.Empty(t, server.Requests("eth_chainId"), "Blocked requests should not be sent")
+}
+
+func TestClient_AccessListTransaction(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err, "Failed to parse storage key")
+	accessList := radius.AccessList{{Address: recipient, StorageKeys: []radius.Hash{slot}}}
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	tx := radius.NewAccessListTransaction(nil, 0, &recipient, big.NewInt(100), 30000, big.NewInt(1), nil, accessList)
+	signed, err := account.Signer.SignTransaction(tx)
+	require.NoError(t, err, "Failed to sign transaction")
+	assert.Equal(t, big.NewInt(1234), signed.ChainID, "Signer chain ID should be used")
+	assert.Nil(t, tx.ChainID, "Original transaction should not be modified")
+
+	decoded := new(types.Transaction)
+	require.NoError(t, decoded.UnmarshalBinary(signed.Serialized), "Failed to decode serialized transaction")
+	assert.Equal(t, uint8(types.AccessListTxType), decoded.Type(), "Unexpected transaction type")
+	assert.Equal(t, accessList.EthAccessList(), decoded.AccessList(), "Access list should survive serialization")
+	assert.Equal(t, decoded.Hash(), signed.EthSignedTransaction().Hash(), "Signed transaction should match serialization")
+
+	sender, err := types.Sender(types.LatestSignerForChainID(decoded.ChainId()), decoded)
+	require.NoError(t, err, "Failed to recover sender")
+	from := account.Address()
+	assert.Equal(t, from.Bytes(), sender.Bytes(), "Unexpected sender")
+
+	_, err = client.Transact(context.Background(), account.Signer, signed)
+	require.NoError(t, err, "Failed to send transaction")
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, accessList.EthAccessList(), sent[0].AccessList(), "Access list should be sent")
+}
+
+func TestClient_TypedTransactions(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err, "Failed to parse storage key")
+	accessList := radius.AccessList{{Address: recipient, StorageKeys: []radius.Hash{slot}}}
+
+	tests := []struct {
+		name string
+		opts radius.TxOptions
+		want uint8
+	}{
+		{name: "legacy", opts: radius.TxOptions{GasPrice: big.NewInt(1)}, want: types.LegacyTxType},
+		{name: "access list", opts: radius.TxOptions{GasPrice: big.NewInt(1), AccessList: accessList}, want: types.AccessListTxType},
+		{name: "dynamic fee", opts: radius.TxOptions{GasFeeCap: big.NewInt(2), GasTipCap: big.NewInt(1)}, want: types.DynamicFeeTxType},
+		{name: "dynamic fee with access list", opts: radius.TxOptions{GasFeeCap: big.NewInt(2), AccessList: accessList}, want: types.DynamicFeeTxType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+
+			tx, err := client.PrepareTxWithOptions(ctx, account.Signer, &recipient, nil, big.NewInt(100), tt.opts)
+			require.NoError(t, err, "Failed to prepare transaction")
+			signed, err := account.Signer.SignTransaction(tx)
+			require.NoError(t, err, "Failed to sign transaction")
+
+			// Typed envelopes start with the type byte, while legacy transactions are RLP lists starting at 0xc0
+			if tt.want == types.LegacyTxType {
+				assert.GreaterOrEqual(t, signed.Serialized[0], byte(0xc0), "Legacy transactions should not have a type byte")
+			} else {
+				assert.Equal(t, tt.want, signed.Serialized[0], "Unexpected envelope type byte")
+			}
+
+			hash, err := client.SendRawTransaction(ctx, signed.Serialized)
+			require.NoError(t, err, "Failed to send raw transaction")
+			receipt, err := client.WaitForReceipt(ctx, hash)
+			require.NoError(t, err, "Failed to wait for receipt")
+			assert.Equal(t, account.Address(), receipt.From, "Unexpected sender")
+
+			sent := server.SentTransactions()
+			require.Len(t, sent, 1, "Unexpected number of transactions")
+			assert.Equal(t, tt.want, sent[0].Type(), "Unexpected transaction type")
+			assert.Len(t, sent[0].AccessList(), len(tt.opts.AccessList), "Unexpected access list")
+			assert.Equal(t, big.NewInt(1234), sent[0].ChainId(), "Unexpected chain ID")
+		})
+	}
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	_, err = client.PrepareTxWithOptions(context.Background(), account.Signer, &recipient, nil, nil,
+		radius.TxOptions{GasPrice: big.NewInt(1), GasFeeCap: big.NewInt(2)})
+	assert.ErrorContains(t, err, "gas price cannot be set with a gas fee cap", "Conflicting fee fields should be rejected")
+	_, err = client.PrepareTxWithOptions(context.Background(), account.Signer, &recipient, nil, nil,
+		radius.TxOptions{GasTipCap: big.NewInt(1)})
+	assert.ErrorContains(t, err, "gas tip cap requires a gas fee cap", "A tip cap without a fee cap should be rejected")
+}
+
+func TestClient_CreateAccessList(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_createAccessList", map[string]interface{}{
+		"accessList": []map[string]interface{}{{
+			"address":     MockContractAddress,
+			"storageKeys": []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+		}},
+		"gasUsed": "0x5208",
+	})
+	client := server.NewClient(t)
+	from := CreateTestAccount(t, client).Address()
+
+	accessList, err := client.CreateAccessList(context.Background(), from,
+		&radius.Transaction{To: &recipient, Data: []byte{0x01}})
+	require.NoError(t, err, "Failed to create access list")
+	require.Len(t, accessList, 1, "Unexpected access list length")
+	assert.Equal(t, recipient, accessList[0].Address, "Unexpected address")
+	require.Len(t, accessList[0].StorageKeys, 1, "Unexpected number of storage keys")
+	assert.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", accessList[0].StorageKeys[0].Hex())
+
+	requests := server.Requests("eth_createAccessList")
+	require.Len(t, requests, 1, "Unexpected number of eth_createAccessList requests")
+	var args map[string]string
+	requests[0].Param(t, 0, &args)
+	assert.Equal(t, from.Hex(), args["from"], "Unexpected sender address")
+	assert.Equal(t, recipient.Hex(), args["to"], "Unexpected destination address")
+	assert.Equal(t, "0x01", args["data"], "Unexpected data")
+
+	server.HandleResult("eth_createAccessList", map[string]interface{}{"accessList": []interface{}{}, "error": "execution reverted"})
+	_, err = client.CreateAccessList(context.Background(), from, &radius.Transaction{To: &recipient})
+	assert.ErrorContains(t, err, "execution reverted", "Simulation errors should be surfaced")
+}
+
+func TestClient_VerifyCodeHash(t *testing.T) {
+	ctx := context.Background()
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	code := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", hexutil.Encode(code))
+	client := server.NewClient(t)
+
+	hash, err := client.CodeHash(ctx, address)
+	require.NoError(t, err, "Failed to get code hash")
+	assert.Equal(t, crypto.Keccak256Hash(code).Hex(), hash.Hex(), "Unexpected code hash")
+
+	known, err := radius.HashFromHex(crypto.Keccak256Hash(code).Hex())
+	require.NoError(t, err, "Failed to parse known hash")
+	ok, err := client.VerifyCodeHash(ctx, address, known)
+	require.NoError(t, err, "Failed to verify code hash")
+	assert.True(t, ok, "The code should match the known hash")
+
+	other, err := radius.HashFromHex(crypto.Keccak256Hash([]byte{0x00}).Hex())
+	require.NoError(t, err, "Failed to parse other hash")
+	ok, err = client.VerifyCodeHash(ctx, address, other)
+	require.NoError(t, err, "Failed to verify code hash")
+	assert.False(t, ok, "The code should not match another hash")
+
+	server.Handle("eth_getCode", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "header not found"}
+	})
+	_, err = client.VerifyCodeHash(ctx, address, known)
+	assert.ErrorContains(t, err, "header not found", "Errors should be returned")
+}
+
+func TestClient_StorageAt(t *testing.T) {
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000002")
+	require.NoError(t, err, "Failed to parse storage slot")
+
+	value := "0x000000000000000000000000000000000000000000000000000000000000002a"
+	server := NewMockServer(t)
+	server.HandleResult("eth_getStorageAt", value)
+	client := server.NewClient(t)
+
+	stored, err := client.StorageAt(context.Background(), address, slot, nil)
+	require.NoError(t, err, "Failed to get storage")
+	assert.Equal(t, value, hexutil.Encode(stored), "Unexpected storage value")
+
+	_, err = client.StorageAt(context.Background(), address, slot, big.NewInt(16))
+	require.NoError(t, err, "Failed to get storage at block")
+
+	requests := server.Requests("eth_getStorageAt")
+	require.Len(t, requests, 2, "Unexpected number of eth_getStorageAt requests")
+
+	var param, block string
+	requests[0].Param(t, 1, &param)
+	requests[0].Param(t, 2, &block)
+	assert.Equal(t, slot.Hex(), param, "Unexpected storage slot")
+	assert.Equal(t, "latest", block, "Nil block number should read the latest block")
+
+	requests[1].Param(t, 2, &block)
+	assert.Equal(t, "0x10", block, "Unexpected block number")
+}
+
+func TestClient_ImplementationAddress(t *testing.T) {
+	proxy, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse proxy address")
+	implementation, err := radius.AddressFromHex("0x00000000000000000000000000000000000000aa")
+	require.NoError(t, err, "Failed to parse implementation address")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getStorageAt", "0x00000000000000000000000000000000000000000000000000000000000000aa")
+	client := server.NewClient(t)
+
+	resolved, err := client.ImplementationAddress(context.Background(), proxy)
+	require.NoError(t, err, "Failed to get implementation address")
+	assert.Equal(t, implementation, resolved, "Unexpected implementation address")
+
+	requests := server.Requests("eth_getStorageAt")
+	require.Len(t, requests, 1, "Unexpected number of eth_getStorageAt requests")
+	var slot string
+	requests[0].Param(t, 1, &slot)
+	assert.Equal(t, "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc", slot, "Unexpected storage slot")
+
+	server.HandleResult("eth_getStorageAt", "0x0000000000000000000000000000000000000000000000000000000000000000")
+	_, err = client.ImplementationAddress(context.Background(), proxy)
+	assert.ErrorContains(t, err, "no EIP-1967 implementation address", "Empty implementation slot should be rejected")
+}
+
+func TestClient_SignOfflineAndSendRaw(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	_, err = radius.SignOffline(radius.NewTransaction(7, &recipient, big.NewInt(100), 0, big.NewInt(1), nil), signer)
+	assert.ErrorContains(t, err, "gas limit is required", "Transactions without a gas limit should be rejected")
+
+	signed, err := radius.SignOffline(radius.NewTransaction(7, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil), signer)
+	require.NoError(t, err, "Failed to sign transaction offline")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+
+	hash, err := client.SendRawTransaction(context.Background(), signed.Serialized)
+	require.NoError(t, err, "Failed to send raw transaction")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, sent[0].Hash().Bytes(), hash.Bytes(), "Unexpected transaction hash")
+	assert.Equal(t, uint64(7), sent[0].Nonce(), "Unexpected nonce")
+	assert.Equal(t, big.NewInt(1234), sent[0].ChainId(), "Unexpected chain ID")
+
+	receipt, err := client.WaitForReceipt(context.Background(), hash)
+	require.NoError(t, err, "Failed to wait for receipt")
+	assert.Equal(t, signer.Address(), receipt.From, "Unexpected sender")
+}
+
+func TestClient_BalancesAtBlock(t *testing.T) {
+	first, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+	second, err := radius.AddressFromHex("0x00000000000000000000000000000000000000aa")
+	require.NoError(t, err, "Failed to parse address")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_blockNumber", "0x20")
+	server.Handle("eth_getBalance", func(params []json.RawMessage) (interface{}, error) {
+		var address string
+		if err := json.Unmarshal(params[0], &address); err != nil {
+			return nil, err
+		}
+		if address == hexAddress(second.Bytes()) {
+			return "0x200", nil
+		}
+		return "0x100", nil
+	})
+	client := server.NewClient(t)
+
+	tests := []struct {
+		name        string
+		blockNumber *big.Int
+		wantBlock   string
+	}{
+		{name: "fixed block", blockNumber: big.NewInt(16), wantBlock: "0x10"},
+		{name: "latest block", blockNumber: nil, wantBlock: "0x20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(server.Requests("eth_getBalance"))
+
+			balances, err := client.BalancesAtBlock(context.Background(), []radius.Address{first, second}, tt.blockNumber)
+			require.NoError(t, err, "Failed to get balances")
+			assert.Equal(t, map[radius.Address]*big.Int{first: big.NewInt(0x100), second: big.NewInt(0x200)}, balances)
+
+			requests := server.Requests("eth_getBalance")[before:]
+			require.Len(t, requests, 2, "Unexpected number of eth_getBalance requests")
+			for _, request := range requests {
+				var block string
+				request.Param(t, 1, &block)
+				assert.Equal(t, tt.wantBlock, block, "All balances should be read at the same block")
+			}
+		})
+	}
+}
+
+func TestClient_DeployIfNotExists(t *testing.T) {
+	bytecode := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+
+	t.Run("code exists", func(t *testing.T) {
+		existing, err := radius.AddressFromHex(MockContractAddress)
+		require.NoError(t, err, "Failed to parse contract address")
+
+		server := NewMockServer(t)
+		server.HandleTransactions()
+		server.HandleResult("eth_getCode", "0x6080604052")
+		client := server.NewClient(t)
+		account := CreateTestAccount(t, client)
+
+		contract, err := client.DeployIfNotExists(context.Background(), account.Signer, existing, nil, bytecode)
+		require.NoError(t, err, "Failed to get existing contract")
+		assert.Equal(t, existing, contract.Address(), "Unexpected contract address")
+		assert.Empty(t, server.SentTransactions(), "Existing contracts should not be redeployed")
+	})
+
+	t.Run("code does not exist", func(t *testing.T) {
+		server := NewMockServer(t)
+		server.HandleTransactions()
+		server.HandleResult("eth_getCode", "0x")
+		client := server.NewClient(t)
+		account := CreateTestAccount(t, client)
+		expected := radius.CreateAddress(account.Address(), 0)
+
+		contract, err := client.DeployIfNotExists(context.Background(), account.Signer, expected, nil, bytecode)
+		require.NoError(t, err, "Failed to deploy contract")
+		assert.Equal(t, expected, contract.Address(), "Unexpected contract address")
+		assert.Len(t, server.SentTransactions(), 1, "Contract should be deployed")
+
+		_, err = client.DeployIfNotExists(context.Background(), account.Signer, radius.CreateAddress(account.Address(), 5), nil, bytecode)
+		assert.ErrorContains(t, err, "next contract deployed by the signer", "Unreachable addresses should be rejected")
+		assert.Len(t, server.SentTransactions(), 1, "Contracts should not be deployed to an unexpected address")
+	})
+
+	t.Run("nonce manager", func(t *testing.T) {
+		recipient, err := radius.AddressFromHex(MockContractAddress)
+		require.NoError(t, err, "Failed to parse recipient address")
+
+		server := NewMockServer(t)
+		server.HandleTransactions()
+		server.HandleResult("eth_getCode", "0x")
+		client := server.NewClient(t, radius.WithNonceManager(radius.NewNonceManager()))
+		account := CreateTestAccount(t, client)
+
+		// The mock server always reports a pending nonce of 0, so only the NonceManager knows nonce 0 is used
+		_, err = client.Send(context.Background(), account.Signer, recipient, big.NewInt(100))
+		require.NoError(t, err, "Failed to send transaction")
+
+		expected := radius.CreateAddress(account.Address(), 1)
+		contract, err := client.DeployIfNotExists(context.Background(), account.Signer, expected, nil, bytecode)
+		require.NoError(t, err, "The expected address should use the nonce reserved by the NonceManager")
+		assert.Equal(t, expected, contract.Address(), "Unexpected contract address")
+	})
+}
+
+func TestClient_DeployValidation(t *testing.T) {
+	bytecode := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+	abi := radius.ABIFromJSON(SimpleStorageABI)
+
+	// Runtime code with a dispatch table for the SimpleStorage methods: DUP1 PUSH4 <selector> EQ for each method
+	var code []byte
+	for _, selector := range abi.MethodSelectors() {
+		code = append(code, 0x80, 0x63)
+		code = append(code, selector[:]...)
+		code = append(code, 0x14)
+	}
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_getCode", hexutil.Encode(code))
+
+	var warnings []string
+	logf := func(format string, args ...interface{}) {
+		if message := fmt.Sprintf(format, args...); strings.HasPrefix(message, "Warning: ") {
+			warnings = append(warnings, message)
+		}
+	}
+	client := server.NewClient(t, radius.WithLogger(logf), radius.WithDeployValidation())
+	account := CreateTestAccount(t, client)
+
+	_, err := client.DeployContract(context.Background(), account.Signer, bytecode, abi)
+	require.NoError(t, err, "Failed to deploy contract")
+	assert.Empty(t, warnings, "Matching ABIs should not log a warning")
+
+	contract, err := client.DeployContract(context.Background(), account.Signer, bytecode, radius.ABIFromJSON(PayableABI))
+	require.NoError(t, err, "Mismatched ABIs should not fail the deployment")
+	require.Len(t, warnings, 1, "Mismatched ABIs should log a warning")
+	address := contract.Address()
+	assert.Contains(t, warnings[0], address.Hex(), "The warning should name the contract")
+	assert.Contains(t, warnings[0], "deposit", "The warning should name the missing methods")
+}
+
+func TestClient_DeployContractWithProgress(t *testing.T) {
+	bytecode, err := hex.DecodeString(SimpleStorageBin)
+	require.NoError(t, err, "Failed to decode bytecode")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	var stages []string
+	contract, err := client.DeployContractWithProgress(context.Background(), account.Signer, bytecode,
+		radius.ABIFromJSON(SimpleStorageABI), func(stage string) { stages = append(stages, stage) })
+	require.NoError(t, err, "Failed to deploy contract")
+	assert.NotEqual(t, radius.ZeroAddress(), contract.Address(), "The contract should have an address")
+	assert.Equal(t, []string{
+		radius.DeployStageEstimating,
+		radius.DeployStageSigning,
+		radius.DeployStageBroadcasting,
+		radius.DeployStageWaiting,
+		radius.DeployStageMined,
+	}, stages, "Stages should be reported in order")
+	assert.Equal(t, []string{"estimating", "signing", "broadcasting", "waiting", "mined"}, stages, "Unexpected stage names")
+
+	// Failed deployments stop reporting at the failed stage
+	stages = nil
+	server.Handle("eth_sendRawTransaction", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "insufficient funds"}
+	})
+	_, err = client.DeployContractWithProgress(context.Background(), account.Signer, bytecode, nil,
+		func(stage string) { stages = append(stages, stage) })
+	assert.ErrorContains(t, err, "insufficient funds", "Send errors should be returned")
+	assert.Equal(t, []string{"estimating", "signing", "broadcasting"}, stages, "Later stages should not be reported")
+}
+
+func TestCreateAddress2(t *testing.T) {
+	// Example 1 from EIP-1014
+	address := radius.CreateAddress2(radius.ZeroAddress(), [32]byte{}, []byte{0x00})
+	assert.Equal(t, "0x4d1a2e2bb4f88f0250f26ffff098b0b30b26bf38", hexAddress(address.Bytes()), "Unexpected CREATE2 address")
+}
+
+func TestClient_RequestIDFunc(t *testing.T) {
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getBalance", "0x64")
+
+	var next atomic.Int64
+	client := server.NewClient(t, radius.WithRequestIDFunc(func() string {
+		return fmt.Sprintf("trace-%d", next.Add(1))
+	}))
+
+	chainID, err := client.ChainID(context.Background())
+	require.NoError(t, err, "Responses should be matched to requests with custom IDs")
+	assert.Equal(t, big.NewInt(1234), chainID, "Unexpected chain ID")
+
+	balances, err := client.BalancesAtBlock(context.Background(), []radius.Address{address}, big.NewInt(1))
+	require.NoError(t, err, "Batch responses should be matched to requests with custom IDs")
+	assert.Equal(t, big.NewInt(100), balances[address], "Unexpected balance")
+
+	var ids []string
+	for _, method := range []string{"eth_chainId", "eth_getBalance"} {
+		requests := server.Requests(method)
+		require.Len(t, requests, 1, "Unexpected number of %s requests", method)
+
+		var id string
+		require.NoError(t, json.Unmarshal(requests[0].ID, &id), "Request ID should be a string")
+		ids = append(ids, id)
+	}
+	assert.Equal(t, []string{"trace-1", "trace-2"}, ids, "Server should receive IDs from the custom generator")
+}
+
+func TestClient_RequestIDFuncStreamsResponses(t *testing.T) {
+	code := bytes.Repeat([]byte{0x60}, 200000)
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", hexutil.Encode(code))
+
+	var read atomic.Int64
+	client := server.NewClient(t,
+		radius.WithHTTPClient(&http.Client{Transport: countingTransport{read: &read}}),
+		radius.WithRequestIDFunc(func() string { return "trace" }),
+	)
+
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"eth_getCode","params":["%s","latest"]}`, MockContractAddress)
+	req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+	require.NoError(t, err, "Failed to create request")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.HTTPClient().Transport.RoundTrip(req)
+	require.NoError(t, err, "Failed to send request")
+	defer resp.Body.Close()
+	assert.Less(t, read.Load(), int64(len(code)), "Single responses should be streamed instead of read into memory")
+
+	var message struct {
+		Result hexutil.Bytes `json:"result"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&message), "Failed to decode response")
+	assert.Equal(t, hexutil.Bytes(code), message.Result, "The full response body should be delivered")
+}
+
+func TestClient_TraceTransaction(t *testing.T) {
+	hash, err := radius.HashFromHex("0x8b8f1b0f4d4d3c5a3b4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f70819203")
+	require.NoError(t, err, "Failed to parse hash")
+
+	server := NewMockServer(t)
+	server.HandleResult("debug_traceTransaction", map[string]interface{}{
+		"gas":         21000,
+		"failed":      true,
+		"returnValue": "",
+		"structLogs": []map[string]interface{}{
+			{"pc": 0, "op": "PUSH1", "gas": 79000, "gasCost": 3, "depth": 1, "stack": []string{}},
+			{"pc": 2, "op": "REVERT", "gas": 78997, "gasCost": 0, "depth": 1, "error": "execution reverted"},
+		},
+	})
+	client := server.NewClient(t)
+
+	raw, err := client.TraceTransaction(context.Background(), hash, radius.TraceOptions{DisableStorage: true})
+	require.NoError(t, err, "Failed to trace transaction")
+
+	var trace radius.StructLogTrace
+	require.NoError(t, json.Unmarshal(raw, &trace), "Failed to decode trace")
+	assert.True(t, trace.Failed, "Trace should report the failure")
+	assert.Equal(t, uint64(21000), trace.Gas, "Unexpected gas")
+	require.Len(t, trace.StructLogs, 2, "Unexpected number of struct logs")
+	assert.Equal(t, "REVERT", trace.StructLogs[1].Op, "Unexpected opcode")
+	assert.Equal(t, "execution reverted", trace.StructLogs[1].Error, "Unexpected opcode error")
+
+	requests := server.Requests("debug_traceTransaction")
+	require.Len(t, requests, 1, "Unexpected number of debug_traceTransaction requests")
+	var opts map[string]interface{}
+	requests[0].Param(t, 1, &opts)
+	assert.Equal(t, map[string]interface{}{"disableStorage": true}, opts, "Unexpected trace options")
+
+	unsupported := NewMockServer(t).NewClient(t)
+	_, err = unsupported.TraceTransaction(context.Background(), hash, radius.TraceOptions{})
+	assert.ErrorIs(t, err, radius.ErrMethodUnsupported, "Disabled debug namespace should be reported")
+}
+
+func TestClient_Health(t *testing.T) {
+	t.Run("healthy", func(t *testing.T) {
+		server := NewMockServer(t)
+		server.HandleResult("eth_blockNumber", "0x20")
+		server.HandleResult("net_peerCount", "0x3")
+		client := server.NewClient(t)
+
+		status, err := client.Health(context.Background())
+		require.NoError(t, err, "Node should be healthy")
+		assert.True(t, status.Reachable, "Node should be reachable")
+		assert.True(t, status.Healthy, "Node should be healthy")
+		assert.Equal(t, big.NewInt(1234), status.ChainID, "Unexpected chain ID")
+		assert.Equal(t, uint64(32), status.LatestBlock, "Unexpected latest block")
+		require.NotNil(t, status.PeerCount, "Peer count should be reported")
+		assert.Equal(t, uint64(3), *status.PeerCount, "Unexpected peer count")
+		assert.Positive(t, status.Latency, "Latency should be measured")
+	})
+
+	t.Run("degraded", func(t *testing.T) {
+		server := NewMockServer(t)
+		server.Handle("eth_blockNumber", func([]json.RawMessage) (interface{}, error) {
+			return nil, &MockError{Code: -32000, Message: "header not found"}
+		})
+		client := server.NewClient(t)
+
+		status, err := client.Health(context.Background())
+		assert.ErrorContains(t, err, "node is degraded", "Node should be degraded")
+		assert.True(t, status.Reachable, "Node should be reachable")
+		assert.False(t, status.Healthy, "Node should not be healthy")
+		assert.Nil(t, status.PeerCount, "Peer count should not be checked")
+	})
+}
+
+func TestClient_EstimateGasRevert(t *testing.T) {
+	// Error("insufficient balance") encoded as revert data
+	reason := "insufficient balance"
+	revertData := hexutil.MustDecode("0x08c379a0")
+	revertData = append(revertData, common.LeftPadBytes([]byte{0x20}, 32)...)
+	revertData = append(revertData, common.LeftPadBytes([]byte{byte(len(reason))}, 32)...)
+	revertData = append(revertData, common.RightPadBytes([]byte(reason), 32)...)
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.Handle("eth_estimateGas", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(revertData)}
+	})
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	contract := newMockContract(t, PayableABI)
+
+	_, err := contract.EstimateGas(context.Background(), client, account.Signer, "deposit")
+	var revertErr *radius.RevertError
+	require.ErrorAs(t, err, &revertErr, "Reverted estimations should return a RevertError")
+	assert.Equal(t, reason, revertErr.Reason, "Unexpected revert reason")
+	assert.Equal(t, revertData, revertErr.Data, "Unexpected revert data")
+	assert.ErrorContains(t, err, "failed to estimate gas: execution reverted: insufficient balance", "Unexpected error")
+
+	_, err = contract.Execute(context.Background(), client, account.Signer, "deposit")
+	require.ErrorAs(t, err, &revertErr, "Transactions that would revert should return a RevertError")
+	assert.Equal(t, reason, revertErr.Reason, "Unexpected revert reason")
+	assert.Empty(t, server.SentTransactions(), "Transactions that would revert should not be sent")
+}
+
+func TestClient_SimulateDeploy(t *testing.T) {
+	bytecode := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+	abi := radius.ABIFromJSON(ConstructorABI)
+
+	// Error("supply must be positive") encoded as revert data
+	reason := "supply must be positive"
+	revertData := hexutil.MustDecode("0x08c379a0")
+	revertData = append(revertData, common.LeftPadBytes([]byte{0x20}, 32)...)
+	revertData = append(revertData, common.LeftPadBytes([]byte{byte(len(reason))}, 32)...)
+	revertData = append(revertData, common.RightPadBytes([]byte(reason), 32)...)
+
+	server := NewMockServer(t)
+	server.Handle("eth_call", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: 3, Message: "execution reverted", Data: hexutil.Encode(revertData)}
+	})
+	client := server.NewClient(t)
+	deployer, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse deployer address")
+
+	err = client.SimulateDeploy(context.Background(), deployer, bytecode, abi, deployer.EthAddress(), big.NewInt(0), "Token")
+	var revertErr *radius.RevertError
+	require.ErrorAs(t, err, &revertErr, "Reverted deployments should return a RevertError")
+	assert.Equal(t, reason, revertErr.Reason, "Unexpected revert reason")
+	assert.Equal(t, revertData, revertErr.Data, "Unexpected revert data")
+
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of calls")
+	var call MockCallArg
+	requests[0].Param(t, 0, &call)
+	assert.Empty(t, call.To, "Deployments should be simulated without a recipient")
+	assert.Equal(t, deployer.Hex(), common.HexToAddress(call.From).Hex(), "Unexpected deployer")
+	assert.True(t, strings.HasPrefix(call.Input, hexutil.Encode(bytecode)), "The bytecode should be simulated")
+	assert.Greater(t, len(call.Input), len(hexutil.Encode(bytecode)), "The constructor arguments should be appended")
+
+	server.HandleResult("eth_call", "0x")
+	assert.NoError(t, client.SimulateDeploy(context.Background(), deployer, bytecode, nil), "Deployment should succeed")
+}
+
+func TestClient_MaxBodyLog(t *testing.T) {
+	code := bytes.Repeat([]byte{0x60}, 200000)
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", hexutil.Encode(code))
+
+	var responses []string
+	logf := func(format string, args ...interface{}) {
+		if message := fmt.Sprintf(format, args...); strings.HasPrefix(message, "Response from ") {
+			responses = append(responses, message)
+		}
+	}
+	client := server.NewClient(t, radius.WithLogger(logf), radius.WithMaxBodyLog(256))
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	result, err := client.CodeAt(context.Background(), address)
+	require.NoError(t, err, "Failed to get code")
+	assert.Equal(t, code, result, "The full response body should be delivered")
+
+	require.Len(t, responses, 1, "Unexpected number of logged responses")
+	body := strings.TrimPrefix(responses[0], fmt.Sprintf("Response from %s: ", server.URL))
+	assert.Equal(t, 256+len("... (truncated)"), len(body), "Only the capped portion should be logged")
+	assert.True(t, strings.HasSuffix(body, "... (truncated)"), "Truncated bodies should be marked")
+}
+
+func TestClient_LogErrorsOnly(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getBalance", "0x64")
+	server.Handle("eth_sendRawTransaction", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "insufficient funds"}
+	})
+
+	var logs []string
+	logf := func(format string, args ...interface{}) {
+		logs = append(logs, fmt.Sprintf(format, args...))
+	}
+	client := server.NewClient(t, radius.WithLogger(logf), radius.WithLogLevel(radius.LogErrorsOnly))
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+
+	_, err = client.BalanceAt(context.Background(), address)
+	require.NoError(t, err, "Failed to get balance")
+	assert.Empty(t, logs, "Successful requests should not be logged")
+
+	raw := []byte{0xde, 0xad, 0xbe, 0xef}
+	_, err = client.SendRawTransaction(context.Background(), raw)
+	require.Error(t, err, "The transaction should be rejected")
+
+	require.Len(t, logs, 1, "Failed requests should be logged")
+	assert.Contains(t, logs[0], "method eth_sendRawTransaction, status 200", "The method and status should be logged")
+	assert.Contains(t, logs[0], "insufficient funds (code -32000)", "The error should be logged")
+	assert.NotContains(t, logs[0], hex.EncodeToString(raw), "The request body should not be logged")
+}
+
+// countingBody counts the bytes read from a response body
+type countingBody struct {
+	io.ReadCloser
+	read *atomic.Int64
+}
+
+// Read implements the io.Reader interface
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read.Add(int64(n))
+	return n, err
+}
+
+// countingTransport wraps response bodies with countingBody
+type countingTransport struct {
+	read *atomic.Int64
+}
+
+// RoundTrip implements the http.RoundTripper interface
+func (t countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = countingBody{ReadCloser: resp.Body, read: t.read}
+	return resp, nil
+}
+
+func TestClient_LogErrorsOnlyLargeResponse(t *testing.T) {
+	code := bytes.Repeat([]byte{0x60}, 200000)
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", hexutil.Encode(code))
+
+	// The interceptor runs after the response is checked for errors, so it sees how much of the body was read
+	var read, readBeforeIntercept atomic.Int64
+	interceptor := func(_ string, resp *http.Response) (*http.Response, error) {
+		readBeforeIntercept.Store(read.Load())
+		return resp, nil
+	}
+	var logs []string
+	logf := func(format string, args ...interface{}) {
+		logs = append(logs, fmt.Sprintf(format, args...))
+	}
+	client := server.NewClient(t,
+		radius.WithHTTPClient(&http.Client{Transport: countingTransport{read: &read}}),
+		radius.WithInterceptor(interceptor),
+		radius.WithLogger(logf),
+		radius.WithLogLevel(radius.LogErrorsOnly),
+	)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	result, err := client.CodeAt(context.Background(), address)
+	require.NoError(t, err, "Failed to get code")
+	assert.Equal(t, code, result, "The full response body should be delivered")
+	assert.Empty(t, logs, "Successful requests should not be logged")
+	assert.Less(t, readBeforeIntercept.Load(), int64(len(code)), "Only the start of the body should be read to check for errors")
+}
+
+func TestClient_WithCache(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", "0x6080604052")
+	client := server.NewClient(t, radius.WithCache(radius.NewMemoryCache()))
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	for i := 0; i < 3; i++ {
+		code, err := client.CodeAt(context.Background(), address)
+		require.NoError(t, err, "Failed to get code")
+		assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, "Unexpected code")
+
+		chainID, err := client.ChainID(context.Background())
+		require.NoError(t, err, "Failed to get chain ID")
+		assert.Equal(t, big.NewInt(1234), chainID, "Unexpected chain ID")
+	}
+	assert.Len(t, server.Requests("eth_getCode"), 1, "Code should be requested once")
+	assert.Len(t, server.Requests("eth_chainId"), 1, "Chain ID should be requested once")
+
+	empty := radius.CreateAddress(address, 0)
+	server.HandleResult("eth_getCode", "0x")
+	for i := 0; i < 2; i++ {
+		_, err = client.CodeAt(context.Background(), empty)
+		require.NoError(t, err, "Failed to get code")
+	}
+	assert.Len(t, server.Requests("eth_getCode"), 3, "Addresses without code should not be cached")
+
+	code, err := client.CodeAt(context.Background(), address)
+	require.NoError(t, err, "Failed to get code")
+	code[0] = 0xff
+	code, err = client.CodeAt(context.Background(), address)
+	require.NoError(t, err, "Failed to get code")
+	assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, "Modifying the returned code should not change the cache")
+
+	// A cache shared with a client of another network does not return the code of the first network
+	cache := radius.NewMemoryCache()
+	for _, chainID := range []string{"0x4d2", "0x1"} {
+		other := NewMockServer(t)
+		other.HandleResult("eth_chainId", chainID)
+		other.HandleResult("eth_getCode", "0x6080604052")
+		_, err = other.NewClient(t, radius.WithCache(cache)).CodeAt(context.Background(), address)
+		require.NoError(t, err, "Failed to get code")
+		assert.Len(t, other.Requests("eth_getCode"), 1, "Code should be cached per chain ID")
+	}
+}
+
+func TestClient_UserAgentAndTransport(t *testing.T) {
+	server := NewMockServer(t)
+
+	var userAgents []string
+	handler := server.Config.Handler
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents = append(userAgents, r.UserAgent())
+		handler.ServeHTTP(w, r)
+	})
+
+	var proxied atomic.Int32
+	transport := &http.Transport{
+		MaxIdleConnsPerHost: 4,
+		Proxy: func(*http.Request) (*url.URL, error) {
+			proxied.Add(1)
+			return nil, nil
+		},
+	}
+	client := server.NewClient(t, radius.WithUserAgent("radius-test/1.0"), radius.WithTransport(transport))
+
+	_, err := client.ChainID(context.Background())
+	require.NoError(t, err, "Failed to get chain ID")
+	assert.Equal(t, []string{"radius-test/1.0"}, userAgents, "The User-Agent should reach the server")
+	assert.Positive(t, proxied.Load(), "Requests should be sent with the custom transport")
+}
+
+func TestClient_NodeInfo(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("net_version", "1234")
+	server.HandleResult("web3_clientVersion", "Geth/v1.15.2-stable/linux-amd64/go1.23.6")
+	server.HandleResult("eth_syncing", map[string]string{
+		"startingBlock": "0x10",
+		"currentBlock":  "0x80",
+		"highestBlock":  "0x100",
+	})
+	client := server.NewClient(t)
+
+	networkID, err := client.NetVersion(context.Background())
+	require.NoError(t, err, "Failed to get network ID")
+	assert.Equal(t, big.NewInt(1234), networkID, "Unexpected network ID")
+
+	version, err := client.ClientVersion(context.Background())
+	require.NoError(t, err, "Failed to get client version")
+	assert.Equal(t, radius.ClientVersion{
+		Raw:     "Geth/v1.15.2-stable/linux-amd64/go1.23.6",
+		Name:    "Geth",
+		Version: "v1.15.2-stable",
+		OS:      "linux-amd64",
+		Runtime: "go1.23.6",
+	}, version, "Unexpected client version")
+
+	progress, err := client.SyncProgress(context.Background())
+	require.NoError(t, err, "Failed to get sync progress")
+	require.NotNil(t, progress, "Syncing nodes should report their progress")
+	assert.Equal(t, radius.SyncProgress{StartingBlock: 0x10, CurrentBlock: 0x80, HighestBlock: 0x100}, *progress, "Unexpected progress")
+	assert.Equal(t, uint64(0x80), progress.RemainingBlocks(), "Unexpected remaining blocks")
+
+	server.HandleResult("eth_syncing", false)
+	progress, err = client.SyncProgress(context.Background())
+	require.NoError(t, err, "Failed to get sync progress")
+	assert.Nil(t, progress, "Synced nodes should report no progress")
+
+	server.HandleResult("web3_clientVersion", "radius")
+	version, err = client.ClientVersion(context.Background())
+	require.NoError(t, err, "Failed to get client version")
+	assert.Equal(t, radius.ClientVersion{Raw: "radius", Name: "radius"}, version, "Unexpected unconventional version")
+}
+
+func TestClient_NetworkInfo(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("net_version", "5678")
+	server.HandleResult("web3_clientVersion", "Geth/v1.15.2-stable/linux-amd64/go1.23.6")
+	client := server.NewClient(t)
+
+	info, err := client.NetworkInfo(context.Background())
+	require.NoError(t, err, "Failed to get network info")
+	assert.Equal(t, big.NewInt(1234), info.ChainID, "Unexpected chain ID")
+	assert.Equal(t, big.NewInt(5678), info.NetworkID, "Unexpected network ID")
+	assert.Equal(t, "Geth", info.ClientVersion.Name, "Unexpected client name")
+	assert.Equal(t, "v1.15.2-stable", info.ClientVersion.Version, "Unexpected client version")
+
+	server.Handle("web3_clientVersion", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "internal error"}
+	})
+	_, err = client.NetworkInfo(context.Background())
+	assert.ErrorContains(t, err, "failed to get client version", "Errors should be returned")
+}
+
+func TestClient_FeeHistory(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_feeHistory", map[string]interface{}{
+		"oldestBlock":   "0x10",
+		"baseFeePerGas": []string{"0x3b9aca00", "0x3b9aca01", "0x3b9aca02"},
+		"gasUsedRatio":  []float64{0.5, 0.25},
+		"reward":        [][]string{{"0x1", "0x2"}, {"0x3", "0x4"}},
+	})
+	client := server.NewClient(t)
+
+	history, err := client.FeeHistory(context.Background(), 2, big.NewInt(0x11), []float64{25, 75})
+	require.NoError(t, err, "Failed to get fee history")
+	assert.Equal(t, &radius.FeeHistory{
+		OldestBlock:   big.NewInt(0x10),
+		BaseFees:      []*big.Int{big.NewInt(1000000000), big.NewInt(1000000001), big.NewInt(1000000002)},
+		GasUsedRatios: []float64{0.5, 0.25},
+		Rewards:       [][]*big.Int{{big.NewInt(1), big.NewInt(2)}, {big.NewInt(3), big.NewInt(4)}},
+	}, history, "Unexpected fee history")
+
+	requests := server.Requests("eth_feeHistory")
+	require.Len(t, requests, 1, "Unexpected number of eth_feeHistory requests")
+	var blockCount, lastBlock string
+	var percentiles []float64
+	requests[0].Param(t, 0, &blockCount)
+	requests[0].Param(t, 1, &lastBlock)
+	requests[0].Param(t, 2, &percentiles)
+	assert.Equal(t, "0x2", blockCount, "Unexpected block count")
+	assert.Equal(t, "0x11", lastBlock, "Unexpected last block")
+	assert.Equal(t, []float64{25, 75}, percentiles, "Unexpected reward percentiles")
+}
+
+// staticIndexer is a TxIndexer that returns the same transactions for every account
+type staticIndexer []*radius.SignedTransaction
+
+// TransactionsByAccount implements the TxIndexer interface
+func (i staticIndexer) TransactionsByAccount(context.Context, radius.Address, *big.Int, *big.Int) ([]*radius.SignedTransaction, error) {
+	return i, nil
+}
+
+func TestClient_TransactionsByAccount(t *testing.T) {
+	server := NewMockServer(t)
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	other := CreateTestAccount(t, client)
+	address, otherAddress := account.Address(), other.Address()
+
+	sign := func(signer radius.Signer, nonce uint64, to radius.Address) *types.Transaction {
+		signed, err := signer.SignTransaction(radius.NewTransaction(nonce, &to, big.NewInt(1), 21000, nil, nil))
+		require.NoError(t, err, "Failed to sign transaction")
+		return signed.EthSignedTransaction()
+	}
+	outgoing := sign(account.Signer, 0, otherAddress)
+	unrelated := sign(other.Signer, 0, otherAddress)
+	incoming := sign(other.Signer, 1, address)
+
+	// A transaction of a type added by a later network upgrade should be skipped without failing the scan
+	unknown := map[string]interface{}{"type": "0x7e", "hash": "0x" + strings.Repeat("ab", 32)}
+	blocks := map[string][]interface{}{
+		"0x1": {outgoing, unrelated},
+		"0x2": {unknown, incoming},
+		"0x3": {},
+	}
+	server.HandleResult("eth_blockNumber", "0x3")
+	server.Handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		var number string
+		if err := json.Unmarshal(params[0], &number); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"number": number, "transactions": blocks[number]}, nil
+	})
+
+	txs, err := client.TransactionsByAccount(context.Background(), address, big.NewInt(1), nil)
+	require.NoError(t, err, "Failed to get transactions")
+	require.Len(t, txs, 2, "Only transactions from or to the account should be returned")
+	for i, want := range []*types.Transaction{outgoing, incoming} {
+		hash := txs[i].Hash()
+		assert.Equal(t, want.Hash().Bytes(), hash.Bytes(), "Transactions should be returned in block order")
+	}
+	sender, err := txs[1].Sender()
+	require.NoError(t, err, "Failed to recover sender")
+	assert.Equal(t, otherAddress, sender, "Unexpected sender")
+	assert.Len(t, server.Requests("eth_getBlockByNumber"), 3, "Each block in the range should be scanned once")
+
+	txs, err = client.TransactionsByAccount(context.Background(), address, big.NewInt(2), big.NewInt(2))
+	require.NoError(t, err, "Failed to get transactions")
+	require.Len(t, txs, 1, "Only blocks in the range should be scanned")
+
+	indexed := staticIndexer{txs[0]}
+	indexedClient := server.NewClient(t, radius.WithTxIndexer(indexed))
+	txs, err = indexedClient.TransactionsByAccount(context.Background(), address, nil, nil)
+	require.NoError(t, err, "Failed to get transactions from the indexer")
+	assert.Equal(t, []*radius.SignedTransaction(indexed), txs, "The indexer should be used when set")
+	assert.Len(t, server.Requests("eth_getBlockByNumber"), 4, "Blocks should not be scanned when an indexer is set")
+}
+
+func TestClient_Endpoints(t *testing.T) {
+	ctx := context.Background()
+	down := NewMockServer(t)
+	down.Close()
+	up := NewMockServer(t)
+	up.HandleResult("eth_blockNumber", "0x2a")
+
+	client, err := radius.NewClientWithEndpoints([]string{down.URL, up.URL})
+	require.NoError(t, err, "Failed to create client with an endpoint down")
+
+	number, err := client.BlockNumber(ctx)
+	require.NoError(t, err, "Read requests should fail over to the next endpoint")
+	assert.Equal(t, uint64(42), number, "Unexpected block number")
+
+	other := NewMockServer(t)
+	other.HandleResult("eth_chainId", "0x1")
+	_, err = radius.NewClientWithEndpoints([]string{up.URL, other.URL})
+	assert.ErrorContains(t, err, "chain ID mismatch", "Endpoints of different networks should be rejected")
+
+	_, err = radius.NewClientWithEndpoints([]string{down.URL})
+	assert.ErrorContains(t, err, "no endpoint can be reached", "A single endpoint should be checked too")
+	_, err = radius.NewClientWithEndpoints([]string{up.URL})
+	assert.NoError(t, err, "A single reachable endpoint should be accepted")
+	_, err = radius.NewClientWithEndpoints(nil)
+	assert.Error(t, err, "At least one endpoint should be required")
+}
+
+func TestClient_EndpointsWriteNotRetried(t *testing.T) {
+	// The first endpoint drops the connection after receiving a transaction, so it may have been submitted
+	dropping := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "eth_sendRawTransaction") {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			require.NoError(t, err, "Failed to hijack connection")
+			_ = conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"%s"}`, MockChainID)
+	}))
+	t.Cleanup(dropping.Close)
+	up := NewMockServer(t)
+	up.HandleTransactions()
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+	signed, err := radius.SignOffline(radius.NewTransaction(0, nil, big.NewInt(0), 21000, big.NewInt(1), nil), signer)
+	require.NoError(t, err, "Failed to sign transaction offline")
+
+	client, err := radius.NewClientWithEndpoints([]string{dropping.URL, up.URL})
+	require.NoError(t, err, "Failed to create client")
+
+	_, err = client.SendRawTransaction(context.Background(), signed.Serialized)
+	assert.Error(t, err, "A transaction that may have been submitted should not be resent")
+	assert.Empty(t, up.SentTransactions(), "The transaction should not be sent to the next endpoint")
+}
+
+func TestClient_ReceiptNotFound(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signed, err := radius.SignOffline(radius.NewTransaction(0, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil),
+		radius.NewKeySignerWithChainID(key, big.NewInt(1234)))
+	require.NoError(t, err, "Failed to sign transaction offline")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	receipt := server.Handler("eth_getTransactionReceipt")
+
+	// The node returns no receipt for the first two requests, although the transaction is mined
+	var polls atomic.Int32
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		if polls.Add(1) <= 2 {
+			return nil, nil
+		}
+		return receipt(params)
+	})
+	client := server.NewClient(t, radius.WithPollInterval(10*time.Millisecond))
+
+	hash, err := client.SendRawTransaction(context.Background(), signed.Serialized)
+	require.NoError(t, err, "Failed to send raw transaction")
+
+	_, err = client.TransactionReceipt(context.Background(), hash)
+	assert.ErrorIs(t, err, radius.ErrNotFound, "A null receipt should be reported as not found")
+
+	mined, err := client.WaitForReceipt(context.Background(), hash)
+	require.NoError(t, err, "Waiting should poll through null receipts")
+	assert.Equal(t, hash, mined.TxHash, "Unexpected receipt transaction hash")
+	assert.Equal(t, int32(3), polls.Load(), "Unexpected number of receipt requests")
+
+	fetched, err := client.TransactionReceipt(context.Background(), hash)
+	require.NoError(t, err, "Failed to get receipt")
+	assert.Equal(t, recipient, fetched.To, "Unexpected recipient")
+
+	// Transient errors are retried until the context is done
+	var failures atomic.Int32
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		if failures.Add(1) <= 2 {
+			return nil, &MockError{Code: -32000, Message: "internal error"}
+		}
+		return receipt(params)
+	})
+	_, err = client.TransactionReceipt(context.Background(), hash)
+	require.Error(t, err, "RPC errors should be returned")
+	assert.NotErrorIs(t, err, radius.ErrNotFound, "RPC errors should not be reported as not found")
+	_, err = client.WaitForReceipt(context.Background(), hash)
+	require.NoError(t, err, "Waiting should poll through transient errors")
+
+	server.Handle("eth_getTransactionReceipt", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "internal error"}
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.WaitForReceipt(ctx, hash)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "Waiting should stop when the context is done")
+	assert.ErrorContains(t, err, "internal error", "The last transient error should be reported")
+
+	// Errors of the request itself would fail again, so waiting stops immediately
+	server.Handle("eth_getTransactionReceipt", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32602, Message: "invalid argument 0"}
+	})
+	_, err = client.WaitForReceipt(context.Background(), hash)
+	assert.ErrorContains(t, err, "invalid argument 0", "Waiting should stop on invalid requests")
+}
+
+func TestClient_GetProof(t *testing.T) {
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err, "Failed to parse storage slot")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getProof", map[string]interface{}{
+		"address":      MockContractAddress,
+		"accountProof": []string{"0xf90211a0", "0xf8718080"},
+		"balance":      "0x64",
+		"codeHash":     "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		"nonce":        "0x3",
+		"storageHash":  "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+		"storageProof": []map[string]interface{}{
+			{"key": "0x1", "value": "0x2a", "proof": []string{"0xe2a0"}},
+		},
+	})
+	client := server.NewClient(t)
+
+	proof, err := client.GetProof(context.Background(), address, []radius.Hash{slot}, big.NewInt(16))
+	require.NoError(t, err, "Failed to get proof")
+	assert.Equal(t, address, proof.Address, "Unexpected address")
+	assert.Equal(t, [][]byte{{0xf9, 0x02, 0x11, 0xa0}, {0xf8, 0x71, 0x80, 0x80}}, proof.AccountProof, "Unexpected account proof")
+	assert.Equal(t, big.NewInt(100), proof.Balance, "Unexpected balance")
+	assert.Equal(t, "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", proof.CodeHash.Hex(), "Unexpected code hash")
+	assert.Equal(t, uint64(3), proof.Nonce, "Unexpected nonce")
+	assert.Equal(t, "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421", proof.StorageHash.Hex(), "Unexpected storage hash")
+	require.Len(t, proof.StorageProof, 1, "Unexpected number of storage proofs")
+	assert.Equal(t, slot.Hex(), proof.StorageProof[0].Key.Hex(), "Short keys should be padded to 32 bytes")
+	assert.Equal(t, big.NewInt(42), proof.StorageProof[0].Value, "Unexpected storage value")
+	assert.Equal(t, [][]byte{{0xe2, 0xa0}}, proof.StorageProof[0].Proof, "Unexpected storage proof")
+
+	var keys []string
+	var block string
+	requests := server.Requests("eth_getProof")
+	require.Len(t, requests, 1, "Unexpected number of eth_getProof requests")
+	requests[0].Param(t, 1, &keys)
+	requests[0].Param(t, 2, &block)
+	assert.Equal(t, []string{slot.Hex()}, keys, "Unexpected storage keys")
+	assert.Equal(t, "0x10", block, "Unexpected block number")
+}
+
+func TestClient_GasObserver(t *testing.T) {
+	type observation struct {
+		method         string
+		gasLimit, used uint64
+	}
+	var observed []observation
+	observer := func(method string, gasLimit, used uint64) {
+		observed = append(observed, observation{method: method, gasLimit: gasLimit, used: used})
+	}
+
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_getCode", "0x")
+	client := server.NewClient(t, radius.WithGasObserver(observer))
+	account := CreateTestAccount(t, client)
+	contract := newMockContract(t, SimpleStorageABI)
+
+	_, err = contract.Execute(context.Background(), client, account.Signer, "set", big.NewInt(42))
+	require.NoError(t, err, "Failed to execute method")
+	_, err = client.Send(context.Background(), account.Signer, recipient, big.NewInt(100))
+	require.NoError(t, err, "Failed to send transaction")
+
+	// A transaction that runs out of gas is mined with a failed status, using its whole gas limit
+	receipt := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		result, err := receipt(params)
+		if mined, ok := result.(*types.Receipt); ok {
+			mined.Status = types.ReceiptStatusFailed
+			mined.GasUsed = 25200
+		}
+		return result, err
+	})
+	_, err = contract.Execute(context.Background(), client, account.Signer, "set", big.NewInt(43))
+	require.ErrorContains(t, err, "transaction failed", "The failed transaction should be reported")
+
+	// The estimate of 21000 gas has the default 20% margin, and the mock receipts use 21000 gas
+	assert.Equal(t, []observation{
+		{method: "set", gasLimit: 25200, used: 21000},
+		{method: "", gasLimit: radius.TransferGas, used: 21000},
+		{method: "set", gasLimit: 25200, used: 25200},
+	}, observed, "The observer should be called with the gas limit and actual usage of each mined transaction")
+}
+
+func TestClient_CallWithOverrides(t *testing.T) {
+	holder, err := radius.AddressFromHex("0x00000000000000000000000000000000000000aa")
+	require.NoError(t, err, "Failed to parse address")
+	token, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err, "Failed to parse storage slot")
+	value, err := radius.HashFromHex("0x000000000000000000000000000000000000000000000000000000000000002a")
+	require.NoError(t, err, "Failed to parse storage value")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_call", mockTrue)
+	client := server.NewClient(t)
+
+	nonce := uint64(7)
+	result, err := client.CallWithOverrides(context.Background(), radius.CallMsg{
+		From: holder,
+		To:   &token,
+		Data: []byte{0xde, 0xad, 0xbe, 0xef},
+	}, big.NewInt(16), radius.StateOverride{
+		holder: {Balance: big.NewInt(1000), Nonce: &nonce},
+		token:  {Code: []byte{0x60, 0x80}, StateDiff: []radius.StorageSlot{{Slot: slot, Value: value}}},
+	})
+	require.NoError(t, err, "Failed to call with overrides")
+	assert.Equal(t, mockTrue, hexutil.Encode(result), "Unexpected result")
+
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+	var call MockCallArg
+	var block string
+	var overrides map[string]map[string]interface{}
+	requests[0].Param(t, 0, &call)
+	requests[0].Param(t, 1, &block)
+	requests[0].Param(t, 2, &overrides)
+	assert.Equal(t, hexAddress(holder.Bytes()), call.From, "Unexpected sender")
+	assert.Equal(t, "0x10", block, "Unexpected block number")
+	assert.Equal(t, map[string]map[string]interface{}{
+		hexAddress(holder.Bytes()): {"balance": "0x3e8", "nonce": "0x7"},
+		hexAddress(token.Bytes()): {
+			"code":      "0x6080",
+			"stateDiff": map[string]interface{}{slot.Hex(): value.Hex()},
+		},
+	}, overrides, "The overrides should be sent as the third parameter")
+
+	// An empty code override clears the code of the account, while a nil code override keeps it
+	_, err = client.CallWithOverrides(context.Background(), radius.CallMsg{From: holder, To: &token}, nil,
+		radius.StateOverride{token: {Code: []byte{}}, holder: {Code: nil}})
+	require.NoError(t, err, "Failed to call with overrides")
+	requests = server.Requests("eth_call")
+	require.Len(t, requests, 2, "Unexpected number of eth_call requests")
+	var cleared map[string]map[string]interface{}
+	requests[1].Param(t, 2, &cleared)
+	assert.Equal(t, map[string]map[string]interface{}{
+		hexAddress(holder.Bytes()): {},
+		hexAddress(token.Bytes()):  {"code": "0x"},
+	}, cleared, "Empty code should be sent to clear the code")
+}
@@ -0,0 +1,614 @@
+package test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// IntegersABI is the ABI of a method with integer parameters of different widths
+const IntegersABI = `[{"inputs":[{"name":"amount","type":"uint256"},{"name":"small","type":"uint8"},{"name":"signed","type":"int16"}],"name":"f","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+func TestABI_PackIntegerStrings(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(IntegersABI))
+	require.NoError(t, err, "Failed to parse ABI")
+
+	large, ok := new(big.Int).SetString("18446744073709551617", 10) // 2^64 + 1
+	require.True(t, ok, "Failed to parse large value")
+
+	tests := []struct {
+		name    string
+		args    []interface{}
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name: "decimal strings",
+			args: []interface{}{"18446744073709551617", "255", "-32768"},
+			want: []interface{}{large, uint8(255), int16(-32768)},
+		},
+		{
+			name: "hex strings",
+			args: []interface{}{"0x10000000000000001", "0x10", "0x7fff"},
+			want: []interface{}{large, uint8(16), int16(32767)},
+		},
+		{
+			name: "leading zeros",
+			args: []interface{}{"0100", "010", "-0x0100"},
+			want: []interface{}{big.NewInt(100), uint8(10), int16(-256)},
+		},
+		{
+			name: "native values",
+			args: []interface{}{large, uint8(1), int16(-1)},
+			want: []interface{}{large, uint8(1), int16(-1)},
+		},
+		{name: "uint8 overflow", args: []interface{}{"1", "256", "0"}, wantErr: true},
+		{name: "int16 overflow", args: []interface{}{"1", "0", "-32769"}, wantErr: true},
+		{name: "negative unsigned", args: []interface{}{"-1", "0", "0"}, wantErr: true},
+		{name: "invalid integer", args: []interface{}{"one", "0", "0"}, wantErr: true},
+		{name: "binary literal", args: []interface{}{"0b101", "0", "0"}, wantErr: true},
+		{name: "octal literal", args: []interface{}{"0o17", "0", "0"}, wantErr: true},
+		{name: "underscores", args: []interface{}{"1_000", "0", "0"}, wantErr: true},
+		{name: "signed hex digits", args: []interface{}{"0x-1", "0", "0"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := radius.ABIFromJSON(IntegersABI).Pack("f", tt.args...)
+			if tt.wantErr {
+				assert.Error(t, err, "Expected packing to fail")
+				return
+			}
+			require.NoError(t, err, "Failed to pack arguments")
+
+			want, err := parsed.Pack("f", tt.want...)
+			require.NoError(t, err, "Failed to pack expected arguments")
+			assert.Equal(t, want, got, "Unexpected encoding")
+		})
+	}
+}
+
+// OutputsABI is the ABI of methods with unnamed, array, and tuple outputs
+const OutputsABI = `[
+	{"inputs":[],"name":"stats","outputs":[{"name":"","type":"uint256"},{"name":"","type":"uint256"},{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"ids","outputs":[{"name":"","type":"uint256[]"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"tier","outputs":[{"components":[{"name":"price","type":"uint256"},{"name":"active","type":"bool"}],"name":"","type":"tuple"}],"stateMutability":"view","type":"function"}
+]`
+
+func TestABI_UnpackOutputs(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(OutputsABI))
+	require.NoError(t, err, "Failed to parse ABI")
+
+	t.Run("unnamed outputs", func(t *testing.T) {
+		data, err := parsed.Methods["stats"].Outputs.Pack(big.NewInt(1), big.NewInt(2), true)
+		require.NoError(t, err, "Failed to pack outputs")
+
+		values, err := radius.ABIFromJSON(OutputsABI).Unpack("stats", data)
+		require.NoError(t, err, "Failed to unpack outputs")
+		assert.Equal(t, []interface{}{big.NewInt(1), big.NewInt(2), true}, values, "All values should be returned in order")
+	})
+
+	t.Run("dynamic array", func(t *testing.T) {
+		ids := []*big.Int{big.NewInt(3), big.NewInt(5), big.NewInt(8)}
+		data, err := parsed.Methods["ids"].Outputs.Pack(ids)
+		require.NoError(t, err, "Failed to pack outputs")
+
+		values, err := radius.ABIFromJSON(OutputsABI).Unpack("ids", data)
+		require.NoError(t, err, "Failed to unpack outputs")
+		require.Len(t, values, 1, "Unexpected number of values")
+		assert.Equal(t, ids, values[0], "Arrays should be returned as slices")
+	})
+
+	t.Run("tuple", func(t *testing.T) {
+		tier := struct {
+			Price  *big.Int `abi:"price"`
+			Active bool     `abi:"active"`
+		}{Price: big.NewInt(100), Active: true}
+		data, err := parsed.Methods["tier"].Outputs.Pack(tier)
+		require.NoError(t, err, "Failed to pack outputs")
+
+		values, err := radius.ABIFromJSON(OutputsABI).Unpack("tier", data)
+		require.NoError(t, err, "Failed to unpack outputs")
+		require.Len(t, values, 1, "Unexpected number of values")
+
+		var got struct {
+			Price  *big.Int
+			Active bool
+		}
+		abi.ConvertType(values[0], &got)
+		assert.Equal(t, big.NewInt(100), got.Price, "Unexpected tuple price")
+		assert.True(t, got.Active, "Unexpected tuple active flag")
+	})
+}
+
+func TestABI_MethodNotFound(t *testing.T) {
+	abi := radius.ABIFromJSON(SimpleStorageABI)
+	require.NotNil(t, abi, "Failed to parse ABI")
+
+	_, err := abi.Pack("sett", big.NewInt(42))
+	assert.EqualError(t, err, "method sett not found in ABI, did you mean set? (available methods: get, set)")
+
+	_, err = abi.Unpack("Get", nil)
+	assert.ErrorContains(t, err, "did you mean get?", "Case differences should be suggested")
+
+	_, err = abi.UnpackToMap("balanceOf", nil)
+	assert.EqualError(t, err, "method balanceOf not found in ABI (available methods: get, set)")
+}
+
+func TestTransaction_NilDefaults(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	tx := radius.NewTransaction(0, &recipient, nil, 21000, nil, nil)
+	assert.Equal(t, big.NewInt(0), tx.Value, "Nil value should default to zero")
+	assert.Equal(t, big.NewInt(0), tx.GasPrice, "Nil gas price should default to zero")
+
+	var ethTx *types.Transaction
+	assert.NotPanics(t, func() {
+		ethTx = (&radius.Transaction{Gas: 21000, To: &recipient}).EthTransaction()
+	}, "Converting a transaction without a value or gas price should not panic")
+	assert.Equal(t, big.NewInt(0), ethTx.Value(), "Unexpected value")
+	assert.Equal(t, big.NewInt(0), ethTx.GasPrice(), "Unexpected gas price")
+}
+
+func TestTransaction_Cost(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   *radius.Transaction
+		want *big.Int
+	}{
+		{name: "zero gas price", tx: &radius.Transaction{Gas: 21000, GasPrice: big.NewInt(0), Value: big.NewInt(100)}, want: big.NewInt(100)},
+		{name: "nil gas price", tx: &radius.Transaction{Gas: 21000, Value: big.NewInt(100)}, want: big.NewInt(100)},
+		{name: "nil value", tx: &radius.Transaction{Gas: 21000, GasPrice: big.NewInt(2)}, want: big.NewInt(42000)},
+		{name: "gas and value", tx: &radius.Transaction{Gas: 50000, GasPrice: big.NewInt(3), Value: big.NewInt(7)}, want: big.NewInt(150007)},
+		{name: "no gas", tx: &radius.Transaction{GasPrice: big.NewInt(3), Value: big.NewInt(7)}, want: big.NewInt(7)},
+		{name: "fee cap", tx: &radius.Transaction{Gas: 21000, GasPrice: big.NewInt(1), GasFeeCap: big.NewInt(5), Value: big.NewInt(1)}, want: big.NewInt(105001)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.tx.Cost(), "Unexpected cost")
+		})
+	}
+}
+
+func TestSignedTransaction_Sender(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	tests := []struct {
+		name string
+		tx   *radius.Transaction
+	}{
+		{name: "legacy", tx: radius.NewTransaction(1, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil)},
+		{name: "access list", tx: radius.NewAccessListTransaction(nil, 1, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil, radius.AccessList{})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := signer.SignTransaction(tt.tx)
+			require.NoError(t, err, "Failed to sign transaction")
+
+			sender, err := signed.Sender()
+			require.NoError(t, err, "Failed to recover sender")
+			assert.Equal(t, signer.Address(), sender, "Unexpected sender")
+
+			signed.R = big.NewInt(0)
+			_, err = signed.Sender()
+			assert.Error(t, err, "Invalid signatures should not recover")
+		})
+	}
+}
+
+func TestDecodeSignedTransaction(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	slot, err := radius.HashFromHex("0x0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err, "Failed to parse storage slot")
+
+	data := []byte{0x60, 0xfe, 0x47, 0xb1}
+	accessList := radius.AccessList{{Address: recipient, StorageKeys: []radius.Hash{slot}}}
+	tests := []struct {
+		name string
+		tx   *radius.Transaction
+	}{
+		{name: "legacy", tx: radius.NewTransaction(1, &recipient, big.NewInt(100), 21000, big.NewInt(1), data)},
+		{name: "contract creation", tx: radius.NewTransaction(2, nil, big.NewInt(0), 100000, big.NewInt(1), data)},
+		{name: "access list", tx: radius.NewAccessListTransaction(nil, 3, &recipient, big.NewInt(100), 21000, big.NewInt(1), data, accessList)},
+		{name: "dynamic fee", tx: &radius.Transaction{
+			Data:      data,
+			Gas:       21000,
+			GasFeeCap: big.NewInt(5),
+			GasTipCap: big.NewInt(2),
+			Nonce:     4,
+			To:        &recipient,
+			Value:     big.NewInt(100),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := signer.SignTransaction(tt.tx)
+			require.NoError(t, err, "Failed to sign transaction")
+
+			decoded, err := radius.DecodeSignedTransaction(signed.Serialized)
+			require.NoError(t, err, "Failed to decode transaction")
+			assert.Equal(t, signed, decoded, "Decoded transaction should match the signed transaction")
+			assert.Equal(t, signed.Hash(), decoded.Hash(), "Unexpected transaction hash")
+
+			sender, err := decoded.Sender()
+			require.NoError(t, err, "Failed to recover sender")
+			assert.Equal(t, signer.Address(), sender, "Unexpected sender")
+		})
+	}
+
+	_, err = radius.DecodeSignedTransaction([]byte{0x01, 0x02})
+	assert.ErrorContains(t, err, "failed to decode transaction", "Invalid bytes should be rejected")
+}
+
+func TestKeySigner_WithChainID(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	// No client is needed, so transactions can be signed offline
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(5678))
+	assert.Equal(t, big.NewInt(5678), signer.ChainID(), "Unexpected chain ID")
+
+	tx := radius.NewAccessListTransaction(nil, 0, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil, nil)
+	signed, err := signer.SignTransaction(tx)
+	require.NoError(t, err, "Failed to sign transaction")
+
+	decoded := new(types.Transaction)
+	require.NoError(t, decoded.UnmarshalBinary(signed.Serialized), "Failed to decode serialized transaction")
+	assert.Equal(t, big.NewInt(5678), decoded.ChainId(), "Unexpected chain ID")
+
+	sender, err := signed.Sender()
+	require.NoError(t, err, "Failed to recover sender")
+	assert.Equal(t, signer.Address(), sender, "Unexpected sender")
+}
+
+func TestSigner_PublicKey(t *testing.T) {
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+	pub, err := signer.PublicKey()
+	require.NoError(t, err, "Failed to get public key")
+	assert.True(t, key.PublicKey.Equal(pub), "Public key should match the private key")
+
+	// Clef signs a message to recover the public key
+	var signed []string
+	server := NewMockServer(t)
+	server.HandleResult("account_version", "6.0.0")
+	server.Handle("account_signData", func(params []json.RawMessage) (interface{}, error) {
+		var data string
+		if err := json.Unmarshal(params[2], &data); err != nil {
+			return nil, err
+		}
+		msg, err := hex.DecodeString(data)
+		if err != nil {
+			return nil, err
+		}
+		signed = append(signed, string(msg))
+		sig, err := signer.SignMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		sig[64] += 27
+		return hexutil.Encode(sig), nil
+	})
+
+	clef, err := radius.NewClefSignerWithChainID(signer.Address(), server.URL, big.NewInt(1234), nil)
+	require.NoError(t, err, "Failed to create Clef signer")
+	for i := 0; i < 2; i++ {
+		pub, err = clef.PublicKey()
+		require.NoError(t, err, "Failed to get Clef public key")
+		assert.True(t, key.PublicKey.Equal(pub), "Clef public key should match the account key")
+	}
+	assert.Len(t, server.Requests("account_signData"), 1, "The Clef public key should be cached")
+
+	// Each request signs a new random nonce, so the signature cannot be replayed
+	other, err := radius.NewClefSignerWithChainID(signer.Address(), server.URL, big.NewInt(1234), nil)
+	require.NoError(t, err, "Failed to create Clef signer")
+	_, err = other.PublicKey()
+	require.NoError(t, err, "Failed to get Clef public key")
+	require.Len(t, signed, 2, "Unexpected number of signed messages")
+	assert.NotEqual(t, signed[0], signed[1], "Public key requests should sign different messages")
+	assert.True(t, strings.HasPrefix(signed[0], "Radius SDK public key request\nNonce: "), "Unexpected message")
+}
+
+func TestKeySigner_MessagePrefix(t *testing.T) {
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	msg := []byte("hello radius")
+
+	recoverSigner := func(hash, sig []byte) radius.Address {
+		pub, err := radius.RecoverPublicKey(hash, sig)
+		require.NoError(t, err, "Failed to recover public key")
+		return radius.NewAddress(crypto.PubkeyToAddress(*pub).Bytes())
+	}
+
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+	sig, err := signer.SignMessage(msg)
+	require.NoError(t, err, "Failed to sign message")
+	assert.Equal(t, signer.Address(), recoverSigner(radius.HashMessage(msg), sig), "Messages should be signed with the Ethereum prefix by default")
+	assert.Equal(t, radius.HashMessage(msg), radius.HashMessageWithPrefix(radius.EthereumMessagePrefix, msg), "Unexpected default prefix")
+
+	prefix := "\x19Radius Signed Message:\n"
+	prefixed := radius.NewKeySignerWithChainID(key, big.NewInt(1234), radius.WithMessagePrefix(prefix))
+	sig, err = prefixed.SignMessage(msg)
+	require.NoError(t, err, "Failed to sign message")
+	assert.Equal(t, signer.Address(), recoverSigner(radius.HashMessageWithPrefix(prefix, msg), sig), "Recovery should use the custom prefix")
+	assert.NotEqual(t, signer.Address(), recoverSigner(radius.HashMessage(msg), sig), "Recovery with the Ethereum prefix should not match")
+}
+
+func TestClefSigner_WithChainID(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("account_version", "6.0.0")
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+
+	signer, err := radius.NewClefSignerWithChainID(address, server.URL, big.NewInt(5678), nil)
+	require.NoError(t, err, "Failed to create Clef signer")
+	assert.Equal(t, big.NewInt(5678), signer.ChainID(), "Unexpected chain ID")
+	assert.Empty(t, server.Requests("eth_chainId"), "The chain ID should not be requested")
+}
+
+func TestKeySigner_SignerType(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	tx := radius.NewTransaction(3, &recipient, big.NewInt(100), 21000, nil, nil)
+	tx.GasFeeCap = big.NewInt(20)
+	tx.GasTipCap = big.NewInt(2)
+
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234), radius.WithSignerType(radius.SignerTypeLondon))
+	signed, err := signer.SignTransaction(tx)
+	require.NoError(t, err, "Failed to sign dynamic fee transaction")
+
+	decoded := new(types.Transaction)
+	require.NoError(t, decoded.UnmarshalBinary(signed.Serialized), "Failed to decode serialized transaction")
+	assert.Equal(t, uint8(types.DynamicFeeTxType), decoded.Type(), "Unexpected transaction type")
+	assert.Equal(t, big.NewInt(20), decoded.GasFeeCap(), "Unexpected fee cap")
+	assert.Equal(t, big.NewInt(2), decoded.GasTipCap(), "Unexpected tip cap")
+	assert.Equal(t, big.NewInt(1234), decoded.ChainId(), "Unexpected chain ID")
+
+	sender, err := types.Sender(types.NewLondonSigner(big.NewInt(1234)), decoded)
+	require.NoError(t, err, "Failed to recover sender")
+	from := signer.Address()
+	assert.Equal(t, from.Bytes(), sender.Bytes(), "Unexpected sender")
+
+	legacy := radius.NewKeySignerWithChainID(key, big.NewInt(1234), radius.WithSignerType(radius.SignerTypeEIP155))
+	_, err = legacy.SignTransaction(tx)
+	assert.Error(t, err, "EIP-155 signers should not sign dynamic fee transactions")
+}
+
+func TestParseUnits(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+		wantErr  bool
+	}{
+		{amount: "1.5", decimals: 18, want: "1500000000000000000"},
+		{amount: "0.000000000000000001", decimals: 18, want: "1"},
+		{amount: "42", decimals: 6, want: "42000000"},
+		{amount: ".5", decimals: 1, want: "5"},
+		{amount: "1.25", decimals: 1, wantErr: true},
+		{amount: "-1", decimals: 18, wantErr: true},
+		{amount: "1e18", decimals: 18, wantErr: true},
+		{amount: "", decimals: 18, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			value, err := radius.ParseUnits(tt.amount, tt.decimals)
+			if tt.wantErr {
+				assert.Error(t, err, "Amount should be rejected")
+				return
+			}
+			require.NoError(t, err, "Failed to parse amount")
+			assert.Equal(t, tt.want, value.String(), "Unexpected value")
+		})
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals int
+		want     string
+	}{
+		{value: "1500000000000000000", decimals: 18, want: "1.5"},
+		{value: "2000000000000000000", decimals: 18, want: "2"},
+		{value: "1", decimals: 18, want: "0.000000000000000001"},
+		{value: "0", decimals: 18, want: "0"},
+		{value: "-1250000", decimals: 6, want: "-1.25"},
+		{value: "42", decimals: 0, want: "42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, ok := new(big.Int).SetString(tt.value, 10)
+			require.True(t, ok, "Failed to parse value")
+			assert.Equal(t, tt.want, radius.FormatUnits(value, tt.decimals), "Unexpected amount")
+
+			if value.Sign() >= 0 {
+				parsed, err := radius.ParseUnits(tt.want, tt.decimals)
+				require.NoError(t, err, "Failed to parse formatted amount")
+				assert.Equal(t, value, parsed, "Formatting should round-trip through ParseUnits")
+			}
+		})
+	}
+
+	assert.Equal(t, "1.5", radius.FormatEther(big.NewInt(1500000000000000000)), "Unexpected ether amount")
+	assert.Equal(t, "0", radius.FormatEther(nil), "Nil amounts should format as zero")
+}
+
+// ConstructorABI is the ABI of a contract with constructor parameters
+const ConstructorABI = `[{"inputs":[{"name":"owner","type":"address"},{"name":"supply","type":"uint256"},{"name":"name","type":"string"}],"stateMutability":"nonpayable","type":"constructor"}]`
+
+func TestABI_UnpackConstructor(t *testing.T) {
+	abi := radius.ABIFromJSON(ConstructorABI)
+	require.NotNil(t, abi, "Failed to parse ABI")
+
+	owner := common.HexToAddress(MockContractAddress)
+	bytecode := []byte{0x60, 0x80, 0x60, 0x40, 0x52}
+	args, err := abi.Pack("", owner, big.NewInt(1000), "Radius")
+	require.NoError(t, err, "Failed to pack constructor arguments")
+
+	values, err := abi.UnpackConstructor(append(append([]byte{}, bytecode...), args...), bytecode)
+	require.NoError(t, err, "Failed to unpack constructor arguments")
+	assert.Equal(t, []interface{}{owner, big.NewInt(1000), "Radius"}, values, "Unexpected constructor arguments")
+
+	_, err = abi.UnpackConstructor(args, bytecode)
+	assert.Error(t, err, "Deployment data without the bytecode should be rejected")
+}
+
+func TestSignedTransaction_Hash(t *testing.T) {
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	signed, err := signer.SignTransaction(radius.NewTransaction(1, &recipient, big.NewInt(100), 21000, big.NewInt(1), nil))
+	require.NoError(t, err, "Failed to sign transaction")
+
+	decoded := new(types.Transaction)
+	require.NoError(t, decoded.UnmarshalBinary(signed.Serialized), "Failed to decode serialized transaction")
+
+	hash := signed.Hash()
+	assert.Equal(t, decoded.Hash().Bytes(), hash.Bytes(), "Hash should match the serialized transaction")
+	assert.Equal(t, signed.EthSignedTransaction().Hash().Bytes(), hash.Bytes(), "Hash should match the eth transaction")
+}
+
+func TestAddresses_SortAndDedupe(t *testing.T) {
+	parse := func(h string) radius.Address {
+		address, err := radius.AddressFromHex(h)
+		require.NoError(t, err, "Failed to parse address %s", h)
+		return address
+	}
+
+	a := parse("0x00000000000000000000000000000000000000aa")
+	b := parse("0x5e97870f263700f46aa00d967821199b9bc5a120")
+	bChecksum := parse("0x5E97870f263700f46aA00D967821199B9bc5a120")
+	c := parse("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	cLower := parse("0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2")
+
+	addresses := []radius.Address{c, b, a, cLower, bChecksum}
+	radius.SortAddresses(addresses)
+	assert.Equal(t, []radius.Address{a, b, b, c, c}, addresses, "Addresses should be sorted by their bytes")
+
+	deduped := radius.DedupeAddresses([]radius.Address{c, b, cLower, a, bChecksum, a})
+	assert.Equal(t, []radius.Address{c, b, a}, deduped, "Duplicates should be removed, keeping the first occurrence")
+	assert.Empty(t, radius.DedupeAddresses(nil), "Deduplicating no addresses should return none")
+
+	set := radius.NewAddressSet(c, b)
+	set.Add(bChecksum)
+	set.Add(a)
+	assert.True(t, set.Contains(cLower), "Addresses should be contained regardless of their letter case")
+	assert.False(t, set.Contains(radius.ZeroAddress()), "Unexpected address in set")
+	assert.Equal(t, []radius.Address{a, b, c}, set.Slice(), "The set should contain each address once, in sorted order")
+}
+
+func TestFunctionSelector(t *testing.T) {
+	assert.Equal(t, [4]byte{0xa9, 0x05, 0x9c, 0xbb}, radius.FunctionSelector("transfer(address,uint256)"), "Unexpected transfer selector")
+	assert.Equal(t, [4]byte{0x70, 0xa0, 0x82, 0x31}, radius.FunctionSelector("balanceOf(address)"), "Unexpected balanceOf selector")
+	assert.Equal(t, radius.FunctionSelector("transfer(address,uint256)"), radius.FunctionSelector(" transfer(address, uint256) "),
+		"Whitespace should be ignored")
+
+	selectors := radius.ABIFromJSON(SimpleStorageABI).MethodSelectors()
+	assert.Equal(t, selectors["set"], radius.FunctionSelector("set(uint256)"), "Selector should match the ABI selector")
+}
+
+func TestAddressFromEth(t *testing.T) {
+	ethAddress := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+
+	address := radius.AddressFromEth(ethAddress)
+	assert.Equal(t, ethAddress.Hex(), address.Hex(), "Unexpected address")
+	assert.Equal(t, ethAddress, address.EthAddress(), "Converting back should return the original eth address")
+
+	parsed, err := radius.AddressFromHex("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	require.NoError(t, err, "Failed to parse address")
+	assert.Equal(t, parsed, radius.AddressFromEth(parsed.EthAddress()), "Converting a Radius address should round-trip")
+	assert.Equal(t, radius.Address{}, radius.AddressFromEth(common.Address{}), "The zero address should convert to the zero address")
+}
+
+func TestAddressFromPublicKey(t *testing.T) {
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	assert.Equal(t, signer.Address(), radius.AddressFromPublicKey(&key.PublicKey), "Unexpected address")
+
+	// The public key recovered from a signature derives the signer address
+	digest := radius.HashMessage([]byte("hello"))
+	sig, err := crypto.Sign(digest, key)
+	require.NoError(t, err, "Failed to sign digest")
+	pub, err := radius.RecoverPublicKey(digest, sig)
+	require.NoError(t, err, "Failed to recover public key")
+	assert.Equal(t, signer.Address(), radius.AddressFromPublicKey(pub), "Unexpected recovered address")
+
+	assert.Equal(t, radius.ZeroAddress(), radius.AddressFromPublicKey(nil), "A nil public key should derive the zero address")
+}
+
+func TestAddressFromHexChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		hex     string
+		wantErr string
+	}{
+		{name: "valid checksum", hex: "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"},
+		{name: "invalid checksum", hex: "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756cC2", wantErr: "invalid address checksum"},
+		{name: "lowercase", hex: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"},
+		{name: "uppercase without prefix", hex: "C02AAA39B223FE8D0A0E5C4F27EAD9083C756CC2"},
+		{name: "invalid hex", hex: "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cz2", wantErr: "invalid hex address"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address, err := radius.AddressFromHexChecked(tt.hex)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr, "Expected an error")
+				return
+			}
+
+			require.NoError(t, err, "Failed to parse address")
+			assert.Equal(t, "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", address.Hex(), "Unexpected address")
+		})
+	}
+
+	// The unchecked parser accepts any letter case
+	_, err := radius.AddressFromHex("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756cC2")
+	assert.NoError(t, err, "AddressFromHex should not validate checksums")
+}
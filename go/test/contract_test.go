@@ -0,0 +1,695 @@
+package test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// TiersABI is the ABI of a method with multiple named outputs
+const TiersABI = `[{"inputs":[{"internalType":"uint256","name":"","type":"uint256"}],"name":"tiers","outputs":[{"internalType":"uint256","name":"price","type":"uint256"},{"internalType":"uint256","name":"ttl","type":"uint256"},{"internalType":"bool","name":"active","type":"bool"}],"stateMutability":"view","type":"function"}]`
+
+// newMockContract returns a Contract with the given ABI at MockContractAddress
+func newMockContract(t *testing.T, abiJSON string) *radius.Contract {
+	abi := radius.ABIFromJSON(abiJSON)
+	require.NotNil(t, abi, "Failed to parse ABI")
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	return radius.NewContract(address, abi)
+}
+
+func TestContract_EstimateGas(t *testing.T) {
+	tests := []struct {
+		name  string
+		value *big.Int
+		want  string
+	}{
+		{name: "without value", want: "0x0"},
+		{name: "with value", value: big.NewInt(100), want: "0x64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server := NewMockServer(t)
+			server.HandleResult("eth_estimateGas", "0x5208")
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+			contract := newMockContract(t, SimpleStorageABI)
+
+			var (
+				gas uint64
+				err error
+			)
+			if tt.value == nil {
+				gas, err = contract.EstimateGas(ctx, client, account.Signer, "set", big.NewInt(42))
+			} else {
+				gas, err = contract.EstimateGasWithValue(ctx, client, account.Signer, tt.value, "set", big.NewInt(42))
+			}
+			require.NoError(t, err, "Failed to estimate gas")
+			assert.Equal(t, uint64(25200), gas, "Estimate should include the gas safety margin")
+
+			data, err := contract.ABI.Pack("set", big.NewInt(42))
+			require.NoError(t, err, "Failed to pack method call")
+
+			requests := server.Requests("eth_estimateGas")
+			require.Len(t, requests, 1, "Unexpected number of eth_estimateGas requests")
+
+			var msg MockCallArg
+			requests[0].Param(t, 0, &msg)
+			assert.Equal(t, "0x"+hex.EncodeToString(data), msg.Input, "Estimate should use the packed method calldata")
+			from := account.Address()
+			assert.Equal(t, strings.ToLower(from.Hex()), msg.From, "Unexpected sender address")
+			assert.Equal(t, MockContractAddress, msg.To, "Unexpected contract address")
+			assert.Equal(t, tt.want, msg.Value, "Unexpected value")
+		})
+	}
+}
+
+func TestContract_CallMap(t *testing.T) {
+	ctx := context.Background()
+	parsed, err := abi.JSON(strings.NewReader(TiersABI))
+	require.NoError(t, err, "Failed to parse ABI")
+
+	output, err := parsed.Methods["tiers"].Outputs.Pack(big.NewInt(100), big.NewInt(3600), true)
+	require.NoError(t, err, "Failed to pack output")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", "0x"+hex.EncodeToString(output))
+	client := server.NewClient(t)
+	contract := newMockContract(t, TiersABI)
+
+	positional, err := contract.Call(ctx, client, "tiers", big.NewInt(1))
+	require.NoError(t, err, "Failed to call contract")
+	require.Len(t, positional, 3, "Unexpected result length")
+
+	named, err := contract.CallMap(ctx, client, "tiers", big.NewInt(1))
+	require.NoError(t, err, "Failed to call contract")
+	require.Len(t, named, 3, "Unexpected result length")
+
+	assert.Equal(t, positional[0], named["price"], "Unexpected price")
+	assert.Equal(t, positional[1], named["ttl"], "Unexpected ttl")
+	assert.Equal(t, positional[2], named["active"], "Unexpected active")
+	assert.Equal(t, big.NewInt(100), named["price"], "Unexpected price")
+	assert.Equal(t, true, named["active"], "Unexpected active")
+}
+
+func TestContract_RawCalldata(t *testing.T) {
+	ctx := context.Background()
+	selector := [4]byte{0xde, 0xad, 0xbe, 0xef}
+	args := common.LeftPadBytes([]byte{0x2a}, 32)
+	want := append(selector[:], args...)
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", mockTrue)
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	contract := radius.NewContract(address, nil)
+
+	result, err := contract.CallRaw(ctx, client, selector, args)
+	require.NoError(t, err, "Failed to call contract")
+	assert.Equal(t, mockTrue, "0x"+hex.EncodeToString(result), "Result should be returned undecoded")
+
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+	var msg MockCallArg
+	requests[0].Param(t, 0, &msg)
+	assert.Equal(t, "0x"+hex.EncodeToString(want), msg.Input, "Calldata should be the selector followed by the arguments")
+
+	receipt, err := contract.ExecuteRaw(ctx, client, account.Signer, selector, args)
+	require.NoError(t, err, "Failed to execute contract")
+	require.NotNil(t, receipt, "Receipt should not be nil")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, want, sent[0].Data(), "Calldata should be the selector followed by the arguments")
+	assert.Equal(t, MockContractAddress, hexAddress(sent[0].To().Bytes()), "Unexpected contract address")
+}
+
+// DepositABI is the ABI of a contract that emits an event with indexed and non-indexed arguments
+const DepositABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":false,"name":"amount","type":"uint256"}],"name":"Deposit","type":"event"}]`
+
+func TestContract_FilterEventsBlockContext(t *testing.T) {
+	user := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	depositID := crypto.Keccak256Hash([]byte("Deposit(address,uint256)"))
+	txHash := common.HexToHash("0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getLogs", []types.Log{{
+		Address:     common.HexToAddress(MockContractAddress),
+		Topics:      []common.Hash{depositID, common.BytesToHash(user.Bytes())},
+		Data:        common.LeftPadBytes(big.NewInt(42).Bytes(), 32),
+		BlockNumber: 1234,
+		TxHash:      txHash,
+		BlockHash:   common.HexToHash("0x02"),
+		Index:       7,
+	}})
+	client := server.NewClient(t)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	contract := radius.NewContract(address, radius.ABIFromJSON(DepositABI))
+
+	events, err := contract.FilterEvents(context.Background(), client, "Deposit", big.NewInt(1000), big.NewInt(2000))
+	require.NoError(t, err, "Failed to filter events")
+	require.Len(t, events, 1, "Unexpected number of events")
+	assert.Equal(t, address, events[0].Address, "Unexpected contract address")
+	assert.Equal(t, uint64(1234), events[0].BlockNumber, "Unexpected block number")
+	assert.Equal(t, uint(7), events[0].LogIndex, "Unexpected log index")
+	assert.Equal(t, txHash.Hex(), events[0].TxHash.Hex(), "Unexpected transaction hash")
+	assert.Equal(t, big.NewInt(42), events[0].Data["amount"], "Unexpected event data")
+}
+
+func TestContract_WatchEvent(t *testing.T) {
+	user := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	depositID := crypto.Keccak256Hash([]byte("Deposit(address,uint256)"))
+
+	// Each eth_blockNumber request advances the chain by one block
+	var head atomic.Uint64
+	head.Store(1)
+
+	server := NewMockServer(t)
+	server.Handle("eth_blockNumber", func([]json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(head.Add(1) - 1), nil
+	})
+	server.Handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		return []types.Log{{
+			Address:     common.HexToAddress(MockContractAddress),
+			Topics:      []common.Hash{depositID, common.BytesToHash(user.Bytes())},
+			Data:        common.LeftPadBytes(big.NewInt(42).Bytes(), 32),
+			BlockNumber: 2,
+			TxHash:      common.HexToHash("0x01"),
+			BlockHash:   common.HexToHash("0x02"),
+		}}, nil
+	})
+	client := server.NewClient(t, radius.WithPollInterval(10*time.Millisecond))
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	contract := radius.NewContract(address, radius.ABIFromJSON(DepositABI))
+
+	filter := radius.NewAddress(user.Bytes())
+	events, cancel, err := contract.WatchEvent(context.Background(), client, "Deposit", filter)
+	require.NoError(t, err, "Failed to watch event")
+
+	select {
+	case event := <-events:
+		assert.Equal(t, "Deposit", event.Name, "Unexpected event name")
+		assert.Equal(t, big.NewInt(42), event.Data["amount"], "Unexpected non-indexed argument")
+		assert.Equal(t, user, event.Data["user"], "Unexpected indexed argument")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+
+	requests := server.Requests("eth_getLogs")
+	require.NotEmpty(t, requests, "No eth_getLogs requests received")
+
+	var query struct {
+		Address []string   `json:"address"`
+		Topics  [][]string `json:"topics"`
+	}
+	requests[0].Param(t, 0, &query)
+	assert.Equal(t, []string{MockContractAddress}, query.Address, "Unexpected contract address")
+	assert.Equal(t, [][]string{{depositID.Hex()}, {common.BytesToHash(user.Bytes()).Hex()}}, query.Topics, "Unexpected topics")
+
+	cancel()
+	for range events {
+	}
+
+	_, _, err = contract.WatchEvent(context.Background(), client, "Withdrawal")
+	assert.Error(t, err, "Unknown events should not be watched")
+	_, _, err = contract.WatchEvent(context.Background(), client, "Deposit", filter, big.NewInt(1))
+	assert.Error(t, err, "Filters for non-indexed arguments should be rejected")
+}
+
+func TestContract_CodeConcurrent(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", "0x6080604052")
+	client := server.NewClient(t)
+	contract := newMockContract(t, TiersABI)
+
+	const workers = 16
+	var wg sync.WaitGroup
+	codes := make([][]byte, workers)
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			codes[i], errs[i] = contract.Code(context.Background(), client)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < workers; i++ {
+		require.NoError(t, errs[i], "Failed to get contract code")
+		assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, codes[i], "Unexpected contract code")
+	}
+	assert.Len(t, server.Requests("eth_getCode"), 1, "Contract code should be retrieved once and cached")
+
+	// Modifying the returned code should not modify the code returned to other callers, or the cached code
+	codes[0][0] = 0xff
+	assert.Equal(t, byte(0x60), codes[1][0], "Callers should not share the returned code")
+	code, err := contract.Code(context.Background(), client)
+	require.NoError(t, err, "Failed to get contract code")
+	assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, "The cached code should not be modified")
+}
+
+func TestContract_CodeWaitCanceled(t *testing.T) {
+	server := NewMockServer(t)
+	started, release := make(chan struct{}), make(chan struct{})
+	server.Handle("eth_getCode", func([]json.RawMessage) (interface{}, error) {
+		close(started)
+		<-release
+		return "0x6080604052", nil
+	})
+	client := server.NewClient(t)
+	contract := newMockContract(t, TiersABI)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := contract.Code(context.Background(), client)
+		done <- err
+	}()
+	<-started
+
+	// A caller waiting for the slow request of another caller should not be blocked past its own deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := contract.Code(ctx, client)
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "The waiting caller should return when its context is done")
+
+	close(release)
+	require.NoError(t, <-done, "Failed to get contract code")
+	code, err := contract.Code(context.Background(), client)
+	require.NoError(t, err, "Failed to get contract code")
+	assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, "Unexpected contract code")
+	assert.Len(t, server.Requests("eth_getCode"), 1, "Contract code should be retrieved once and cached")
+}
+
+func TestContract_CodeNotDeployed(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleResult("eth_getCode", "0x")
+	client := server.NewClient(t)
+	contract := newMockContract(t, TiersABI)
+
+	code, err := contract.Code(context.Background(), client)
+	require.NoError(t, err, "Failed to get contract code")
+	assert.Empty(t, code, "Undeployed contracts should have no code")
+
+	// The contract is deployed later, so its code should be retrieved again
+	server.HandleResult("eth_getCode", "0x6080604052")
+	for i := 0; i < 2; i++ {
+		code, err = contract.Code(context.Background(), client)
+		require.NoError(t, err, "Failed to get contract code")
+		assert.Equal(t, []byte{0x60, 0x80, 0x60, 0x40, 0x52}, code, "Unexpected contract code")
+	}
+	assert.Len(t, server.Requests("eth_getCode"), 2, "Deployed code should be cached")
+}
+
+// PayableABI is the ABI of a payable method without parameters
+const PayableABI = `[{"inputs":[],"name":"deposit","outputs":[],"stateMutability":"payable","type":"function"}]`
+
+func TestContract_ExecuteWithEther(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	contract := newMockContract(t, PayableABI)
+
+	_, err := contract.ExecuteWithEther(context.Background(), client, account.Signer, "1.5", "deposit")
+	require.NoError(t, err, "Failed to execute with ether")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	want, _ := new(big.Int).SetString("1500000000000000000", 10)
+	assert.Equal(t, want, sent[0].Value(), "1.5 ether should be sent as wei")
+
+	_, err = contract.ExecuteWithEther(context.Background(), client, account.Signer, "1.5 ether", "deposit")
+	assert.Error(t, err, "Invalid ether amounts should be rejected")
+	assert.Len(t, server.SentTransactions(), 1, "Invalid ether amounts should not be sent")
+}
+
+func TestContract_MultipleClients(t *testing.T) {
+	contract := newMockContract(t, TiersABI)
+	price, ttl := big.NewInt(100), big.NewInt(3600)
+
+	primary := NewMockServer(t)
+	primary.HandleTransactions()
+	primary.Handle("eth_call", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "endpoint unavailable"}
+	})
+
+	secondary := NewMockServer(t)
+	secondary.HandleTransactions()
+	secondary.Handle("eth_call", func([]json.RawMessage) (interface{}, error) {
+		return "0x" + hex.EncodeToString(common.LeftPadBytes(price.Bytes(), 32)) +
+			hex.EncodeToString(common.LeftPadBytes(ttl.Bytes(), 32)) +
+			hex.EncodeToString(common.LeftPadBytes([]byte{1}, 32)), nil
+	})
+
+	_, err := contract.Call(context.Background(), primary.NewClient(t), "tiers", big.NewInt(1))
+	require.Error(t, err, "Primary endpoint should fail")
+
+	result, err := contract.Call(context.Background(), secondary.NewClient(t), "tiers", big.NewInt(1))
+	require.NoError(t, err, "Same contract should be callable through another client")
+	assert.Equal(t, []interface{}{price, ttl, true}, result, "Unexpected result")
+	assert.Len(t, secondary.Requests("eth_call"), 1, "Call should be routed to the secondary client")
+}
+
+func TestContract_GasPresets(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	contract := newMockContract(t, PayableABI)
+	contract.GasPresets = map[string]uint64{"deposit": 50000}
+
+	_, err := contract.Execute(context.Background(), client, account.Signer, "deposit")
+	require.NoError(t, err, "Failed to execute method with gas preset")
+	assert.Empty(t, server.Requests("eth_estimateGas"), "Gas estimation should be skipped for preset methods")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, uint64(50000), sent[0].Gas(), "Preset gas limit should be used")
+
+	// A transaction that reverts without using its whole gas limit did not fail because of the preset
+	gasUsed := uint64(30000)
+	accept := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		receipt, err := accept(params)
+		if r, ok := receipt.(*types.Receipt); ok && err == nil {
+			r.Status = types.ReceiptStatusFailed
+			r.GasUsed = gasUsed
+		}
+		return receipt, err
+	})
+
+	_, err = contract.Execute(context.Background(), client, account.Signer, "deposit")
+	require.ErrorContains(t, err, "transaction failed", "The failed transaction should be reported")
+	assert.NotContains(t, err.Error(), "gas preset", "Reverted transactions should not blame the preset")
+
+	gasUsed = 50000
+	_, err = contract.Execute(context.Background(), client, account.Signer, "deposit")
+	assert.ErrorContains(t, err, "gas limit 50000 from the deposit gas preset may be too low", "Preset should be reported")
+
+	server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "intrinsic gas too low"}
+	})
+	_, err = contract.Execute(context.Background(), client, account.Signer, "deposit")
+	assert.ErrorContains(t, err, "gas preset may be too low", "Preset should be reported when the node rejects the gas limit")
+}
+
+func TestContract_ExecuteWithOptions(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	contract := newMockContract(t, PayableABI)
+	contract.GasPresets = map[string]uint64{"deposit": 50000}
+
+	nonce := uint64(7)
+	_, err := contract.ExecuteWithOptions(context.Background(), client, account.Signer, "deposit", radius.ExecuteOptions{
+		Value:    big.NewInt(1000),
+		Gas:      80000,
+		GasPrice: big.NewInt(3),
+		Nonce:    &nonce,
+	})
+	require.NoError(t, err, "Failed to execute method with options")
+	assert.Empty(t, server.Requests("eth_estimateGas"), "Gas estimation should be skipped when the gas is overridden")
+	assert.Empty(t, server.Requests("eth_getTransactionCount"), "The nonce should not be requested when overridden")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, big.NewInt(1000), sent[0].Value(), "Value override should be used")
+	assert.Equal(t, uint64(80000), sent[0].Gas(), "Gas override should take precedence over the gas preset")
+	assert.Equal(t, big.NewInt(3), sent[0].GasPrice(), "Gas price override should be used")
+	assert.Equal(t, uint64(7), sent[0].Nonce(), "Nonce override should be used")
+}
+
+func TestContract_ExecuteWithOptionsNonceTooLow(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.Handle("eth_sendRawTransaction", func([]json.RawMessage) (interface{}, error) {
+		return nil, &MockError{Code: -32000, Message: "nonce too low"}
+	})
+	client := server.NewClient(t, radius.WithNonceManager(radius.NewNonceManager()))
+	account := CreateTestAccount(t, client)
+
+	// A pinned nonce, e.g. to replace a pending transaction, must not be resynced into a new transaction
+	nonce := uint64(3)
+	_, err := newMockContract(t, PayableABI).ExecuteWithOptions(context.Background(), client, account.Signer, "deposit",
+		radius.ExecuteOptions{Value: big.NewInt(1000), Nonce: &nonce})
+	assert.ErrorContains(t, err, "nonce too low", "Error should be surfaced for a pinned nonce")
+	assert.Len(t, server.Requests("eth_sendRawTransaction"), 1, "Transaction should not be resent")
+	assert.Empty(t, server.Requests("eth_getTransactionCount"), "The nonce should not be resynced")
+}
+
+func TestContract_EncodeCall(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	contract := newMockContract(t, SimpleStorageABI)
+	to, data, err := contract.EncodeCall("set", big.NewInt(42))
+	require.NoError(t, err, "Failed to encode call")
+	assert.Equal(t, contract.Address(), to, "Unexpected target")
+
+	_, err = contract.Execute(context.Background(), client, account.Signer, "set", big.NewInt(42))
+	require.NoError(t, err, "Failed to execute method")
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	assert.Equal(t, sent[0].Data(), data, "Encoded calldata should match the executed calldata")
+
+	payable := newMockContract(t, PayableABI)
+	to, value, data, err := payable.EncodeCallWithValue(big.NewInt(1000), "deposit")
+	require.NoError(t, err, "Failed to encode call with value")
+	assert.Equal(t, payable.Address(), to, "Unexpected target")
+	assert.Equal(t, big.NewInt(1000), value, "Unexpected value")
+	assert.Len(t, data, 4, "Methods without arguments should encode only the selector")
+
+	_, _, err = contract.EncodeCall("missing")
+	assert.Error(t, err, "Unknown methods should not be encoded")
+}
+
+// FactoryABI is the ABI of a factory contract that emits an event for each contract it creates
+const FactoryABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"instance","type":"address"}],"name":"Created","type":"event"}]`
+
+func TestClient_Bind(t *testing.T) {
+	instance := common.HexToAddress("0x00000000000000000000000000000000000000dd")
+	created, err := radius.HashFromHex(crypto.Keccak256Hash([]byte("Created(address)")).Hex())
+	require.NoError(t, err, "Failed to parse event ID")
+	topic, err := radius.HashFromHex(common.BytesToHash(instance.Bytes()).Hex())
+	require.NoError(t, err, "Failed to parse topic")
+
+	// Discover the address of the created contract from the factory event
+	data, err := radius.ABIFromJSON(FactoryABI).UnpackEvent("Created", radius.Event{Topics: []radius.Hash{created, topic}})
+	require.NoError(t, err, "Failed to decode factory event")
+	address := radius.NewAddress(data["instance"].(common.Address).Bytes())
+
+	output := common.BigToHash(big.NewInt(42)).Bytes()
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", hexutil.Encode(output))
+	client := server.NewClient(t)
+
+	abi := radius.ABIFromJSON(SimpleStorageABI)
+	contract := client.Bind(address, abi)
+	assert.Equal(t, address, contract.Address(), "Unexpected contract address")
+	assert.Equal(t, radius.NewContract(address, abi), contract, "Bind should match NewContract")
+
+	result, err := contract.Call(context.Background(), client, "get")
+	require.NoError(t, err, "Failed to call bound contract")
+	assert.Equal(t, []interface{}{big.NewInt(42)}, result, "Unexpected result")
+
+	var call MockCallArg
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+	requests[0].Param(t, 0, &call)
+	assert.Equal(t, hexAddress(instance.Bytes()), call.To, "The call should be sent to the bound address")
+}
+
+func TestContract_ReadVar(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(TiersABI))
+	require.NoError(t, err, "Failed to parse ABI")
+
+	output, err := parsed.Methods["tiers"].Outputs.Pack(big.NewInt(100), big.NewInt(3600), true)
+	require.NoError(t, err, "Failed to pack output")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", hexutil.Encode(output))
+	client := server.NewClient(t)
+	contract := newMockContract(t, TiersABI)
+
+	tier, err := contract.ReadVar(context.Background(), client, "tiers", big.NewInt(7))
+	require.NoError(t, err, "Failed to read mapping")
+	assert.Equal(t, []interface{}{big.NewInt(100), big.NewInt(3600), true}, tier, "Unexpected tier")
+
+	want, err := parsed.Pack("tiers", big.NewInt(7))
+	require.NoError(t, err, "Failed to pack call")
+	var call MockCallArg
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+	requests[0].Param(t, 0, &call)
+	assert.Equal(t, hexutil.Encode(want), call.Input, "The key should be passed to the getter")
+}
+
+func TestContract_ExecuteWithGasMultiplier(t *testing.T) {
+	tests := []struct {
+		name       string
+		multiplier float64
+		want       uint64
+	}{
+		{name: "multiplied", multiplier: 1.5, want: 31500},
+		{name: "rounded up", multiplier: 1.00001, want: 21001},
+		{name: "capped at max gas", multiplier: 1e9, want: radius.MaxGas},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+			contract := newMockContract(t, PayableABI)
+
+			_, err := contract.ExecuteWithGasMultiplier(context.Background(), client, account.Signer, tt.multiplier, "deposit")
+			require.NoError(t, err, "Failed to execute method with gas multiplier")
+
+			sent := server.SentTransactions()
+			require.Len(t, sent, 1, "Unexpected number of transactions")
+			assert.Equal(t, tt.want, sent[0].Gas(), "Gas limit should be the estimate times the multiplier")
+		})
+	}
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	_, err := newMockContract(t, PayableABI).ExecuteWithGasMultiplier(context.Background(), client, account.Signer, 0.5, "deposit")
+	assert.ErrorContains(t, err, "must be at least 1", "Multipliers below 1 should be rejected")
+}
+
+func TestContract_WithABI(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(TiersABI))
+	require.NoError(t, err, "Failed to parse ABI")
+
+	output, err := parsed.Methods["tiers"].Outputs.Pack(big.NewInt(100), big.NewInt(3600), true)
+	require.NoError(t, err, "Failed to pack output")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", hexutil.Encode(output))
+	client := server.NewClient(t)
+
+	contract := newMockContract(t, SimpleStorageABI)
+	contract.GasPresets = map[string]uint64{"set": 50000}
+	_, err = contract.Call(context.Background(), client, "tiers", big.NewInt(7))
+	require.Error(t, err, "The method should not be found in the original ABI")
+
+	rebound := contract.WithABI(radius.ABIFromJSON(TiersABI))
+	assert.Equal(t, contract.Address(), rebound.Address(), "The address should be kept")
+	assert.Equal(t, contract.GasPresets, rebound.GasPresets, "The gas presets should be kept")
+
+	tier, err := rebound.Call(context.Background(), client, "tiers", big.NewInt(7))
+	require.NoError(t, err, "Failed to call method in the new ABI")
+	assert.Equal(t, []interface{}{big.NewInt(100), big.NewInt(3600), true}, tier, "Unexpected tier")
+
+	rebound.GasPresets["tiers"] = 30000
+	assert.NotContains(t, contract.GasPresets, "tiers", "The original gas presets should not be modified")
+	_, _, err = contract.EncodeCall("tiers", big.NewInt(7))
+	assert.Error(t, err, "The original ABI should not be modified")
+}
+
+func TestContract_View(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", "0x000000000000000000000000000000000000000000000000000000000000002a")
+	client := server.NewClient(t)
+	contract := newMockContract(t, SimpleStorageABI)
+
+	result, err := contract.View(context.Background(), client, "get")
+	require.NoError(t, err, "Failed to call view method")
+	assert.Equal(t, []interface{}{big.NewInt(42)}, result, "Unexpected result")
+
+	_, err = contract.View(context.Background(), client, "set", big.NewInt(42))
+	assert.ErrorContains(t, err, "method set is not view or pure", "Nonpayable methods should be refused")
+	assert.Len(t, server.Requests("eth_call"), 1, "Refused methods should not be called")
+	assert.Empty(t, server.SentTransactions(), "View should never send a transaction")
+
+	view, err := contract.ABI.IsView("get")
+	require.NoError(t, err, "Failed to check method")
+	assert.True(t, view, "get should be a view method")
+}
+
+func TestContract_CallWithTypes(t *testing.T) {
+	selector := radius.FunctionSelector("status(uint256)")
+	args := common.LeftPadBytes([]byte{0x07}, 32)
+	output := append(common.LeftPadBytes([]byte{0x2a}, 32), common.LeftPadBytes([]byte{0x01}, 32)...)
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.HandleResult("eth_call", hexutil.Encode(output))
+	client := server.NewClient(t)
+
+	// The method is missing from the ABI, so the output types are given inline
+	contract := newMockContract(t, SimpleStorageABI)
+	result, err := contract.CallWithTypes(context.Background(), client, selector, args, []string{"uint256", "bool"})
+	require.NoError(t, err, "Failed to call contract")
+	assert.Equal(t, []interface{}{big.NewInt(42), true}, result, "Unexpected result")
+
+	var msg MockCallArg
+	requests := server.Requests("eth_call")
+	require.Len(t, requests, 1, "Unexpected number of eth_call requests")
+	requests[0].Param(t, 0, &msg)
+	assert.Equal(t, hexutil.Encode(append(selector[:], args...)), msg.Input, "Calldata should be the selector followed by the arguments")
+
+	_, err = contract.CallWithTypes(context.Background(), client, selector, args, []string{"uintx"})
+	assert.ErrorContains(t, err, "invalid type uintx", "Invalid types should be rejected")
+}
+
+func TestContract_Equals(t *testing.T) {
+	other, err := radius.AddressFromHex("0x00000000000000000000000000000000000000aa")
+	require.NoError(t, err, "Failed to parse address")
+
+	contract := newMockContract(t, SimpleStorageABI)
+	same := newMockContract(t, TiersABI)
+	different := radius.NewContract(other, contract.ABI)
+
+	assert.True(t, contract.Equals(same), "Contracts at the same address should be equal")
+	assert.True(t, contract.Equals(contract.WithABI(nil)), "Contracts with different ABIs should be equal")
+	assert.False(t, contract.Equals(different), "Contracts at different addresses should not be equal")
+	assert.False(t, contract.Equals(nil), "A contract should not equal nil")
+
+	// The flat and layered APIs return the address by value
+	address := contract.Address()
+	assert.IsType(t, radius.Address{}, address, "The address should be returned by value")
+	assert.Equal(t, MockContractAddress, hexAddress(address.Bytes()), "Unexpected address")
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/testutil"
+)
+
+func TestGeneratePrivateKey(t *testing.T) {
+	key, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	require.NotNil(t, key, "Private key should not be nil")
+
+	other, err := radius.GeneratePrivateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	assert.NotEqual(t, key.D, other.D, "Generated private keys should be unique")
+	assert.Equal(t, crypto.S256(), key.Curve, "Private key should use the secp256k1 curve")
+}
+
+func TestKeySigner_Address(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, nil)
+
+	want := radius.AddressFromEth(crypto.PubkeyToAddress(key.PublicKey))
+	assert.Equal(t, want, signer.Address(), "Address should be derived from the key")
+	assert.Equal(t, signer.Address(), signer.Address(), "Address should be stable across calls")
+}
+
+func TestNewDeterministicSigner(t *testing.T) {
+	assert.Equal(t, testutil.DeterministicKey("alice"), testutil.DeterministicKey("alice"), "The same seed should yield the same key")
+
+	signer := testutil.NewDeterministicSigner("alice", big.NewInt(1234))
+	again := testutil.NewDeterministicSigner("alice", big.NewInt(1234))
+	other := testutil.NewDeterministicSigner("bob", big.NewInt(1234))
+
+	assert.Equal(t, signer.Address(), again.Address(), "The same seed should yield the same address")
+	assert.NotEqual(t, signer.Address(), other.Address(), "Different seeds should yield different addresses")
+
+	msg := []byte("hello")
+	sig, err := signer.SignMessage(msg)
+	require.NoError(t, err, "Failed to sign message")
+	sigAgain, err := again.SignMessage(msg)
+	require.NoError(t, err, "Failed to sign message")
+	assert.Equal(t, sig, sigAgain, "The same seed should yield the same signatures")
+}
+
+func TestSignLargeMessage(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, big.NewInt(1234))
+
+	data := bytes.Repeat([]byte("payload"), 100000)
+	sig, err := radius.SignLargeMessage(signer, data)
+	require.NoError(t, err, "Failed to sign large message")
+
+	// The signature recovers against the prefixed large message hash, not the bare hash of the data
+	pub, err := radius.RecoverPublicKey(radius.HashLargeMessage(data), sig)
+	require.NoError(t, err, "Failed to recover public key")
+	assert.Equal(t, signer.Address(), radius.AddressFromPublicKey(pub), "The signature should recover the signer")
+	pub, err = radius.RecoverPublicKey(crypto.Keccak256(data), sig)
+	require.NoError(t, err, "Failed to recover public key")
+	assert.NotEqual(t, signer.Address(), radius.AddressFromPublicKey(pub), "The bare hash of the data should not be signed")
+
+	_, err = radius.SignLargeMessage(struct{ radius.Signer }{signer}, data)
+	assert.ErrorContains(t, err, "cannot sign large messages", "Signers without large message signing should be rejected")
+}
+
+func BenchmarkKeySigner_Address(b *testing.B) {
+	key, err := crypto.GenerateKey()
+	require.NoError(b, err, "Failed to generate private key")
+	signer := radius.NewKeySignerWithChainID(key, nil)
+
+	b.Run("cached", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = signer.Address()
+		}
+	})
+	b.Run("derived", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = crypto.PubkeyToAddress(key.PublicKey)
+		}
+	})
+}
+
+func TestPublicKeyBytes(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	compressed := radius.PublicKeyBytes(&key.PublicKey, true)
+	assert.Len(t, compressed, 33, "Unexpected compressed public key length")
+	assert.Contains(t, []byte{0x02, 0x03}, compressed[0], "Unexpected compressed public key prefix")
+
+	uncompressed := radius.PublicKeyBytes(&key.PublicKey, false)
+	assert.Len(t, uncompressed, 65, "Unexpected uncompressed public key length")
+	assert.Equal(t, byte(0x04), uncompressed[0], "Unexpected uncompressed public key prefix")
+
+	assert.Equal(t, compressed[1:], uncompressed[1:33], "Both forms should share the X coordinate")
+	assert.Nil(t, radius.PublicKeyBytes(nil, true), "Nil public key should serialize to nil")
+}
+
+func TestRecoverPublicKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	digest := crypto.Keccak256([]byte("hello radius"))
+	sig, err := crypto.Sign(digest, key)
+	require.NoError(t, err, "Failed to sign digest")
+
+	pub, err := radius.RecoverPublicKey(digest, sig)
+	require.NoError(t, err, "Failed to recover public key")
+	assert.True(t, key.PublicKey.Equal(pub), "Recovered public key should match the signing key")
+
+	_, err = radius.RecoverPublicKey(digest, sig[:64])
+	assert.Error(t, err, "Malformed signature should not recover")
+}
+
+func TestSplitSignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+
+	sig, err := crypto.Sign(crypto.Keccak256([]byte("hello radius")), key)
+	require.NoError(t, err, "Failed to sign digest")
+
+	r, s, v, err := radius.SplitSignature(sig)
+	require.NoError(t, err, "Failed to split signature")
+	assert.Equal(t, sig[:32], r[:], "Unexpected r value")
+	assert.Equal(t, sig[32:64], s[:], "Unexpected s value")
+	assert.Equal(t, sig[64], v, "Unexpected v value")
+	assert.Equal(t, sig, radius.JoinSignature(r, s, v), "Joined signature should match the original")
+
+	for _, length := range []int{0, 64, 66} {
+		_, _, _, err := radius.SplitSignature(make([]byte, length))
+		assert.Error(t, err, "Signature of length %d should be rejected", length)
+	}
+}
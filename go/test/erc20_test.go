@@ -0,0 +1,190 @@
+package test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/erc20"
+)
+
+// erc20Outputs maps the ERC-20 view methods to the values returned by the mock token
+var erc20Outputs = map[string]interface{}{
+	"allowance":   big.NewInt(500),
+	"balanceOf":   big.NewInt(1000),
+	"decimals":    uint8(18),
+	"name":        "Radius Token",
+	"symbol":      "RAD",
+	"totalSupply": new(big.Int).Exp(big.NewInt(10), big.NewInt(24), nil),
+}
+
+// handleERC20Calls registers an eth_call handler that returns the encoded erc20Outputs value of the called method
+func handleERC20Calls(t *testing.T, server *MockServer) {
+	abiJSON, err := os.ReadFile("../radius/erc20/ERC20.abi")
+	require.NoError(t, err, "Failed to read ERC-20 ABI")
+	parsed, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	require.NoError(t, err, "Failed to parse ERC-20 ABI")
+
+	server.Handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		var msg MockCallArg
+		if err := json.Unmarshal(params[0], &msg); err != nil {
+			return nil, err
+		}
+
+		input, err := hex.DecodeString(strings.TrimPrefix(msg.Input, "0x"))
+		if err != nil || len(input) < 4 {
+			return nil, fmt.Errorf("invalid input: %s", msg.Input)
+		}
+
+		method, err := parsed.MethodById(input[:4])
+		if err != nil {
+			return nil, err
+		}
+
+		output, err := method.Outputs.Pack(erc20Outputs[method.Name])
+		if err != nil {
+			return nil, err
+		}
+		return "0x" + hex.EncodeToString(output), nil
+	})
+}
+
+func TestToken_Calls(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	handleERC20Calls(t, server)
+	client := server.NewClient(t)
+	ctx := context.Background()
+
+	owner := CreateTestAccount(t, client).Address()
+	spender := CreateTestAccount(t, client).Address()
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	token := erc20.New(address)
+
+	tests := []struct {
+		name     string
+		call     func() (interface{}, error)
+		selector string
+		want     interface{}
+	}{
+		{
+			name:     "Allowance",
+			call:     func() (interface{}, error) { return token.Allowance(ctx, client, owner, spender) },
+			selector: "dd62ed3e",
+			want:     erc20Outputs["allowance"],
+		},
+		{
+			name:     "BalanceOf",
+			call:     func() (interface{}, error) { return token.BalanceOf(ctx, client, owner) },
+			selector: "70a08231",
+			want:     erc20Outputs["balanceOf"],
+		},
+		{
+			name:     "Decimals",
+			call:     func() (interface{}, error) { return token.Decimals(ctx, client) },
+			selector: "313ce567",
+			want:     erc20Outputs["decimals"],
+		},
+		{
+			name:     "Name",
+			call:     func() (interface{}, error) { return token.Name(ctx, client) },
+			selector: "06fdde03",
+			want:     erc20Outputs["name"],
+		},
+		{
+			name:     "Symbol",
+			call:     func() (interface{}, error) { return token.Symbol(ctx, client) },
+			selector: "95d89b41",
+			want:     erc20Outputs["symbol"],
+		},
+		{
+			name:     "TotalSupply",
+			call:     func() (interface{}, error) { return token.TotalSupply(ctx, client) },
+			selector: "18160ddd",
+			want:     erc20Outputs["totalSupply"],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			require.NoError(t, err, "Failed to call token")
+			assert.Equal(t, tt.want, got, "Unexpected decoded output")
+
+			requests := server.Requests("eth_call")
+			require.NotEmpty(t, requests, "No eth_call requests received")
+
+			var msg MockCallArg
+			requests[len(requests)-1].Param(t, 0, &msg)
+			assert.True(t, strings.HasPrefix(msg.Input, "0x"+tt.selector), "Unexpected method selector: %s", msg.Input)
+			assert.Equal(t, MockContractAddress, strings.ToLower(msg.To), "Unexpected contract address")
+		})
+	}
+}
+
+func TestToken_Transactions(t *testing.T) {
+	tokenABI := erc20.ABI()
+	recipient := CreateTestAccount(t, NewMockServer(t).NewClient(t)).Address()
+	amount := big.NewInt(250)
+
+	tests := []struct {
+		name     string
+		send     func(*erc20.Token, *radius.Client, radius.Signer) (*radius.Receipt, error)
+		selector string
+		method   string
+		args     []interface{}
+	}{
+		{
+			name: "Approve",
+			send: func(tok *erc20.Token, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return tok.Approve(context.Background(), c, s, recipient, amount)
+			},
+			selector: "095ea7b3",
+			method:   "approve",
+			args:     []interface{}{recipient.EthAddress(), amount},
+		},
+		{
+			name: "Transfer",
+			send: func(tok *erc20.Token, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return tok.Transfer(context.Background(), c, s, recipient, amount)
+			},
+			selector: "a9059cbb",
+			method:   "transfer",
+			args:     []interface{}{recipient.EthAddress(), amount},
+		},
+		{
+			name: "TransferFrom",
+			send: func(tok *erc20.Token, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return tok.TransferFrom(context.Background(), c, s, recipient, recipient, amount)
+			},
+			selector: "23b872dd",
+			method:   "transferFrom",
+			args:     []interface{}{recipient.EthAddress(), recipient.EthAddress(), amount},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receipt, sent, _ := sendMockTransaction(t, erc20.New, tt.send)
+			assert.True(t, receipt.Succeeded(), "Transaction should succeed")
+
+			want, err := tokenABI.Pack(tt.method, tt.args...)
+			require.NoError(t, err, "Failed to pack method call")
+
+			assert.Equal(t, tt.selector, hex.EncodeToString(sent.Data()[:4]), "Unexpected method selector")
+			assert.Equal(t, want, sent.Data(), "Unexpected method encoding")
+			assert.Equal(t, MockContractAddress, hexAddress(sent.To().Bytes()), "Unexpected contract address")
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/erc721"
+)
+
+// mockTransferLog returns an ERC-721 Transfer event log emitted by the mock contract in the given block
+func mockTransferLog(from, to common.Address, tokenID int64, block uint64) types.Log {
+	return types.Log{
+		Address: common.HexToAddress(MockContractAddress),
+		Topics: []common.Hash{
+			crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")),
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+			common.BigToHash(big.NewInt(tokenID)),
+		},
+		Data:        []byte{},
+		BlockNumber: block,
+		TxHash:      common.HexToHash("0x01"),
+		BlockHash:   common.HexToHash("0x02"),
+	}
+}
+
+func TestNFT_Calls(t *testing.T) {
+	owner := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	uri := "data:application/json;base64,eyJuYW1lIjoiUmFkaXVzIn0="
+
+	stringType, err := abi.NewType("string", "", nil)
+	require.NoError(t, err, "Failed to create string type")
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	server.Handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		var msg MockCallArg
+		if err := json.Unmarshal(params[0], &msg); err != nil {
+			return nil, err
+		}
+
+		method, err := erc721.ABI().Pack("ownerOf", big.NewInt(7))
+		if err != nil {
+			return nil, err
+		}
+		if msg.Input == "0x"+hex.EncodeToString(method) {
+			return "0x" + hex.EncodeToString(common.LeftPadBytes(owner.Bytes(), 32)), nil
+		}
+
+		output, err := abi.Arguments{{Type: stringType}}.Pack(uri)
+		if err != nil {
+			return nil, err
+		}
+		return "0x" + hex.EncodeToString(output), nil
+	})
+	client := server.NewClient(t)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+	nft := erc721.New(address)
+
+	got, err := nft.OwnerOf(context.Background(), client, big.NewInt(7))
+	require.NoError(t, err, "Failed to get owner")
+	assert.Equal(t, hexAddress(owner.Bytes()), hexAddress(got.Bytes()), "Unexpected owner")
+
+	tokenURI, err := nft.TokenURI(context.Background(), client, big.NewInt(8))
+	require.NoError(t, err, "Failed to get token URI")
+	assert.Equal(t, uri, tokenURI, "Data URIs should be returned as is")
+}
+
+func TestNFT_Transactions(t *testing.T) {
+	nftABI := erc721.ABI()
+	recipient := CreateTestAccount(t, NewMockServer(t).NewClient(t)).Address()
+	tokenID := big.NewInt(7)
+
+	tests := []struct {
+		name     string
+		send     func(*erc721.Token, *radius.Client, radius.Signer) (*radius.Receipt, error)
+		selector string
+		method   string
+		args     func(radius.Signer) []interface{}
+	}{
+		{
+			name: "Approve",
+			send: func(nft *erc721.Token, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return nft.Approve(context.Background(), c, s, recipient, tokenID)
+			},
+			selector: "095ea7b3",
+			method:   "approve",
+			args: func(radius.Signer) []interface{} {
+				return []interface{}{recipient.EthAddress(), tokenID}
+			},
+		},
+		{
+			name: "SafeTransferFrom",
+			send: func(nft *erc721.Token, c *radius.Client, s radius.Signer) (*radius.Receipt, error) {
+				return nft.SafeTransferFrom(context.Background(), c, s, s.Address(), recipient, tokenID)
+			},
+			selector: "42842e0e",
+			method:   "safeTransferFrom",
+			args: func(s radius.Signer) []interface{} {
+				from := s.Address()
+				return []interface{}{from.EthAddress(), recipient.EthAddress(), tokenID}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, sent, signer := sendMockTransaction(t, erc721.New, tt.send)
+
+			want, err := nftABI.Pack(tt.method, tt.args(signer)...)
+			require.NoError(t, err, "Failed to pack method call")
+
+			assert.Equal(t, tt.selector, hex.EncodeToString(sent.Data()[:4]), "Unexpected method selector")
+			assert.Equal(t, want, sent.Data(), "Unexpected method encoding")
+		})
+	}
+}
+
+func TestNFT_FilterTransfers(t *testing.T) {
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	server := NewMockServer(t)
+	server.HandleResult("eth_getLogs", []types.Log{mockTransferLog(from, to, 7, 3)})
+	client := server.NewClient(t)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	transfers, err := erc721.New(address).FilterTransfers(context.Background(), client, big.NewInt(1), big.NewInt(5))
+	require.NoError(t, err, "Failed to filter transfers")
+	require.Len(t, transfers, 1, "Unexpected number of transfers")
+	assert.Equal(t, hexAddress(from.Bytes()), hexAddress(transfers[0].From.Bytes()), "Unexpected sender")
+	assert.Equal(t, hexAddress(to.Bytes()), hexAddress(transfers[0].To.Bytes()), "Unexpected recipient")
+	assert.Equal(t, big.NewInt(7), transfers[0].TokenID, "Unexpected token ID")
+
+	requests := server.Requests("eth_getLogs")
+	require.Len(t, requests, 1, "Unexpected number of eth_getLogs requests")
+
+	var query struct {
+		FromBlock string     `json:"fromBlock"`
+		ToBlock   string     `json:"toBlock"`
+		Address   []string   `json:"address"`
+		Topics    [][]string `json:"topics"`
+	}
+	requests[0].Param(t, 0, &query)
+	assert.Equal(t, "0x1", query.FromBlock, "Unexpected from block")
+	assert.Equal(t, "0x5", query.ToBlock, "Unexpected to block")
+	assert.Equal(t, []string{MockContractAddress}, query.Address, "Unexpected contract address")
+	require.Len(t, query.Topics, 1, "Unexpected topics")
+	assert.Equal(t, []string{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")).Hex()}, query.Topics[0])
+}
+
+func TestNFT_WatchTransfers(t *testing.T) {
+	from := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	to := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	// Each eth_blockNumber request advances the chain by one block
+	var head atomic.Uint64
+	head.Store(1)
+
+	server := NewMockServer(t)
+	server.Handle("eth_blockNumber", func([]json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(head.Add(1) - 1), nil
+	})
+	server.Handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		var query struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		}
+		if err := json.Unmarshal(params[0], &query); err != nil {
+			return nil, err
+		}
+
+		logs := []types.Log{}
+		for _, log := range []types.Log{mockTransferLog(from, to, 7, 1), mockTransferLog(from, to, 8, 2)} {
+			if log.BlockNumber >= uint64(query.FromBlock) && log.BlockNumber <= uint64(query.ToBlock) {
+				logs = append(logs, log)
+			}
+		}
+		return logs, nil
+	})
+	client := server.NewClient(t)
+
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	transfers, errs := erc721.New(address).WatchTransfers(ctx, client, 10*time.Millisecond)
+
+	select {
+	case transfer := <-transfers:
+		assert.Equal(t, big.NewInt(8), transfer.TokenID, "Transfers before the watch started should be skipped")
+		assert.Equal(t, strings.ToLower(to.Hex()), hexAddress(transfer.To.Bytes()), "Unexpected recipient")
+	case err := <-errs:
+		t.Fatalf("Watch failed: %v", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for transfer")
+	}
+
+	cancel()
+	for range transfers {
+	}
+	assert.Empty(t, errs, "Cancellation should not be reported as an error")
+}
+
+func TestNFT_WatchTransfersErrors(t *testing.T) {
+	malformed := mockTransferLog(common.Address{}, common.Address{}, 7, 1)
+	malformed.Topics = malformed.Topics[:2]
+
+	tests := []struct {
+		name string
+		logs func() (interface{}, error)
+		want string
+	}{
+		{
+			name: "failed poll",
+			logs: func() (interface{}, error) { return nil, &MockError{Code: -32000, Message: "query timeout"} },
+			want: "query timeout",
+		},
+		{
+			name: "undecodable log",
+			logs: func() (interface{}, error) { return []types.Log{malformed}, nil },
+			want: "failed to decode event",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var head atomic.Uint64
+			server := NewMockServer(t)
+			server.Handle("eth_blockNumber", func([]json.RawMessage) (interface{}, error) {
+				return hexutil.Uint64(head.Add(1)), nil
+			})
+			server.Handle("eth_getLogs", func([]json.RawMessage) (interface{}, error) {
+				return tt.logs()
+			})
+			client := server.NewClient(t)
+
+			address, err := radius.AddressFromHex(MockContractAddress)
+			require.NoError(t, err, "Failed to parse contract address")
+
+			transfers, errs := erc721.New(address).WatchTransfers(context.Background(), client, 10*time.Millisecond)
+			select {
+			case err := <-errs:
+				assert.ErrorContains(t, err, tt.want, "Unexpected watch error")
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for the watch error")
+			}
+
+			for range transfers {
+			}
+		})
+	}
+}
@@ -65,7 +65,7 @@ func SkipIfNoEndpoint(t *testing.T) string {
 
 // SkipIfInsufficientTestAccountBalance skips the test if the account doesn't have enough funds
 func SkipIfInsufficientTestAccountBalance(ctx context.Context, t *testing.T, account *radius.Account, client *radius.Client) *big.Int {
-	balance, err := account.Balance(ctx, client)
+	balance, err := account.BalanceWith(ctx, client)
 	require.NoError(t, err, "Failed to get balance")
 	if balance.Cmp(MinTestAccountBalance) == -1 {
 		t.Skip("Test account has insufficient balance")
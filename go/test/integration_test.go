@@ -33,7 +33,7 @@ func TestIntegration(t *testing.T) {
 
 		// Send ETH from test account to recipient
 		var receipt *radius.Receipt
-		receipt, err = account.Send(ctx, client, recipient.Address(), amount)
+		receipt, err = account.SendWith(ctx, client, recipient.Address(), amount)
 		assert.NoError(t, err, "Failed to send value to recipient")
 		require.NotNil(t, receipt, "Receipt should not be nil")
 		assert.Equal(t, account.Address(), receipt.From, "Unexpected sender address")
@@ -42,13 +42,13 @@ func TestIntegration(t *testing.T) {
 
 		// Check sender balance
 		var senderBalance *big.Int
-		senderBalance, err = account.Balance(ctx, client)
+		senderBalance, err = account.BalanceWith(ctx, client)
 		assert.NoError(t, err, "Failed to get sender balance")
 		assert.Equal(t, initialBalance.Sub(initialBalance, amount), senderBalance, "Unexpected sender balance")
 
 		// Check recipient balance
 		var recipientBalance *big.Int
-		recipientBalance, err = recipient.Balance(ctx, client)
+		recipientBalance, err = recipient.BalanceWith(ctx, client)
 		assert.NoError(t, err, "Failed to get recipient balance")
 		assert.Equal(t, amount, recipientBalance, "Unexpected recipient balance")
 	})
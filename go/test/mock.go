@@ -0,0 +1,286 @@
+package test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+)
+
+// MockChainID is the chain ID reported by a MockServer
+const MockChainID = "0x4d2" // 1234
+
+// MockContractAddress is the address of the contract used by the contract and token unit tests
+const MockContractAddress = "0x5e97870f263700f46aa00d967821199b9bc5a120"
+
+// MockHandler handles a single JSON-RPC method call received by a MockServer
+type MockHandler func(params []json.RawMessage) (interface{}, error)
+
+// MockError is a JSON-RPC error returned by a MockHandler
+type MockError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+// Error implements the error interface
+func (e *MockError) Error() string {
+	return e.Message
+}
+
+// MockRequest is a JSON-RPC request received by a MockServer
+type MockRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+// MockServer is a JSON-RPC server used to unit test the SDK without a Radius node
+type MockServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]MockHandler
+	requests []MockRequest
+	sent     []*types.Transaction
+}
+
+// NewMockServer starts a new MockServer that is closed when the test completes
+func NewMockServer(t *testing.T) *MockServer {
+	m := &MockServer{handlers: make(map[string]MockHandler)}
+	m.HandleResult("eth_chainId", MockChainID)
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Close)
+	return m
+}
+
+// NewClient creates a Radius Client connected to the MockServer
+func (m *MockServer) NewClient(t *testing.T, opts ...radius.ClientOption) *radius.Client {
+	client, err := radius.NewClient(m.URL, opts...)
+	require.NoError(t, err, "Failed to create client")
+	return client
+}
+
+// Handle registers a handler for the given JSON-RPC method
+func (m *MockServer) Handle(method string, handler MockHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers[method] = handler
+}
+
+// Handler returns the handler registered for the given JSON-RPC method, or nil if there is none
+func (m *MockServer) Handler(method string) MockHandler {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.handlers[method]
+}
+
+// HandleResult registers a handler that always returns the given result for the JSON-RPC method
+func (m *MockServer) HandleResult(method string, result interface{}) {
+	m.Handle(method, func([]json.RawMessage) (interface{}, error) {
+		return result, nil
+	})
+}
+
+// Requests returns the requests received for the given JSON-RPC method, in the order they were received
+func (m *MockServer) Requests(method string) []MockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var requests []MockRequest
+	for _, req := range m.requests {
+		if req.Method == method {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+// serveHTTP handles single and batched JSON-RPC requests
+func (m *MockServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	var batch []MockRequest
+	if err = json.Unmarshal(body, &batch); err == nil {
+		responses := make([]map[string]interface{}, len(batch))
+		for i, req := range batch {
+			responses[i] = m.dispatch(req)
+		}
+		_ = json.NewEncoder(w).Encode(responses)
+		return
+	}
+
+	var req MockRequest
+	if err = json.Unmarshal(body, &req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(m.dispatch(req))
+}
+
+// dispatch records the request and builds its JSON-RPC response using the registered handler
+func (m *MockServer) dispatch(req MockRequest) map[string]interface{} {
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	handler, ok := m.handlers[req.Method]
+	m.mu.Unlock()
+
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	if !ok {
+		resp["error"] = &MockError{Code: -32601, Message: fmt.Sprintf("the method %s does not exist/is not available", req.Method)}
+		return resp
+	}
+
+	result, err := handler(req.Params)
+	if err != nil {
+		if mockErr, isMockErr := err.(*MockError); isMockErr {
+			resp["error"] = mockErr
+		} else {
+			resp["error"] = &MockError{Code: -32000, Message: err.Error()}
+		}
+		return resp
+	}
+
+	resp["result"] = result
+	return resp
+}
+
+// MockCallArg is the call object sent as the first parameter of eth_call and eth_estimateGas requests
+type MockCallArg struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Input string `json:"input"`
+	Value string `json:"value"`
+}
+
+// Param decodes the JSON-RPC request parameter at the given index into v
+func (r MockRequest) Param(t *testing.T, i int, v interface{}) {
+	require.Greater(t, len(r.Params), i, "Missing parameter %d for %s", i, r.Method)
+	require.NoError(t, json.Unmarshal(r.Params[i], v), "Failed to decode parameter %d for %s", i, r.Method)
+}
+
+// HandleTransactions registers handlers that accept signed transactions, and return the transaction and a successful
+// receipt for each transaction that has been sent
+func (m *MockServer) HandleTransactions() {
+	m.HandleResult("eth_getTransactionCount", "0x0")
+	m.HandleResult("eth_estimateGas", "0x5208")
+	m.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var raw hexutil.Bytes
+		if err := json.Unmarshal(params[0], &raw); err != nil {
+			return nil, err
+		}
+
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(raw); err != nil {
+			return nil, err
+		}
+
+		m.mu.Lock()
+		m.sent = append(m.sent, tx)
+		m.mu.Unlock()
+
+		return tx.Hash(), nil
+	})
+	m.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+
+		for _, tx := range m.SentTransactions() {
+			if tx.Hash() == hash {
+				return MockReceipt(tx), nil
+			}
+		}
+		return nil, nil
+	})
+	m.Handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+
+		for _, tx := range m.SentTransactions() {
+			if tx.Hash() == hash {
+				return tx, nil
+			}
+		}
+		return nil, nil
+	})
+}
+
+// sendMockTransaction sends a single transaction with a new test account to the contract created by newContract at
+// MockContractAddress, using a MockServer that accepts transactions. It returns the receipt, the signed transaction
+// received by the server, and the signer of the test account.
+func sendMockTransaction[T any](
+	t *testing.T,
+	newContract func(radius.Address) T,
+	send func(T, *radius.Client, radius.Signer) (*radius.Receipt, error),
+) (*radius.Receipt, *types.Transaction, radius.Signer) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+	address, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse contract address")
+
+	receipt, err := send(newContract(address), client, account.Signer)
+	require.NoError(t, err, "Failed to send transaction")
+	require.NotNil(t, receipt, "Receipt should not be nil")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, 1, "Unexpected number of transactions")
+	return receipt, sent[0], account.Signer
+}
+
+// SentTransactions returns the signed transactions received by eth_sendRawTransaction, in the order they were sent
+func (m *MockServer) SentTransactions() []*types.Transaction {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*types.Transaction(nil), m.sent...)
+}
+
+// MockReceipt returns a successful receipt for the given transaction
+func MockReceipt(tx *types.Transaction) *types.Receipt {
+	receipt := &types.Receipt{
+		Type:              tx.Type(),
+		Status:            types.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		GasUsed:           21000,
+		TxHash:            tx.Hash(),
+		Logs:              []*types.Log{},
+		BlockNumber:       big.NewInt(1),
+	}
+
+	if tx.To() == nil {
+		if from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+			receipt.ContractAddress = crypto.CreateAddress(from, tx.Nonce())
+		}
+	}
+
+	return receipt
+}
+
+// hexAddress returns the lowercase hex representation of an address
+func hexAddress(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
@@ -0,0 +1,436 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/erc20"
+	"github.com/radiustechsystems/sdk/go/radius/erc721"
+)
+
+func TestClient_WaitForStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    uint64
+		want      radius.TransactionStatus
+		succeeded bool
+		str       string
+	}{
+		{name: "failed", status: types.ReceiptStatusFailed, want: radius.StatusFailed, succeeded: false, str: "failed"},
+		{name: "success", status: types.ReceiptStatusSuccessful, want: radius.StatusSuccess, succeeded: true, str: "success"},
+		{name: "unknown", status: 7, want: radius.TransactionStatus(7), succeeded: false, str: "unknown(7)"},
+	}
+
+	txHash := common.HexToHash("0x8b8f1b0f4d4d3c5a3b4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f70819203")
+	hash, err := radius.HashFromHex(txHash.Hex())
+	require.NoError(t, err, "Failed to parse hash")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+				return &types.Receipt{
+					Status:      tt.status,
+					TxHash:      txHash,
+					GasUsed:     21000,
+					Logs:        []*types.Log{},
+					BlockNumber: big.NewInt(1),
+				}, nil
+			})
+			client := server.NewClient(t)
+
+			status, err := client.WaitForStatus(context.Background(), hash)
+			require.NoError(t, err, "Failed to wait for status")
+			assert.Equal(t, tt.want, status, "Unexpected status")
+			assert.Equal(t, tt.str, status.String(), "Unexpected status string")
+
+			receipt := radius.Receipt{Status: tt.status}
+			assert.Equal(t, tt.want, receipt.TxStatus(), "Unexpected receipt status")
+			assert.Equal(t, tt.succeeded, receipt.Succeeded(), "Unexpected Succeeded result")
+		})
+	}
+}
+
+func TestReceiptBatch(t *testing.T) {
+	batch := radius.ReceiptBatch{
+		{GasUsed: 21000, Status: 1},
+		{GasUsed: 45000, Status: 1},
+		{GasUsed: 30000, Status: 1},
+	}
+	assert.Equal(t, uint64(96000), batch.TotalGasUsed(), "Unexpected total gas used")
+	assert.True(t, batch.AllSucceeded(), "All transactions should have succeeded")
+
+	batch = append(batch, &radius.Receipt{GasUsed: 4000, Status: 0})
+	assert.Equal(t, uint64(100000), batch.TotalGasUsed(), "Failed transactions should count towards gas used")
+	assert.False(t, batch.AllSucceeded(), "Batches with a failed transaction should not succeed")
+
+	assert.Equal(t, uint64(0), radius.ReceiptBatch{}.TotalGasUsed(), "Empty batches should use no gas")
+}
+
+func TestDecoder_DecodeReceipt(t *testing.T) {
+	token := common.HexToAddress(MockContractAddress)
+	vault := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	unknown := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	user := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	// The transaction to the vault emits a Transfer event from the token, and Deposit events from the vault and an
+	// unregistered contract
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	accept := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		transfer := mockTransferLog(user, vault, 7, 1)
+		deposit := types.Log{
+			Address: vault,
+			Topics:  []common.Hash{crypto.Keccak256Hash([]byte("Deposit(address,uint256)")), common.BytesToHash(user.Bytes())},
+			Data:    common.BigToHash(big.NewInt(500)).Bytes(),
+		}
+		other := types.Log{Address: unknown, Topics: deposit.Topics, Data: deposit.Data}
+
+		receipt, err := accept(params)
+		if r, ok := receipt.(*types.Receipt); ok && err == nil {
+			r.Logs = []*types.Log{&transfer, &deposit, &other}
+		}
+		return receipt, err
+	})
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	receipt, err := account.SendWith(context.Background(), client, radius.NewAddress(vault.Bytes()), big.NewInt(500))
+	require.NoError(t, err, "Failed to send transaction")
+
+	decoder := radius.NewDecoder()
+	decoder.RegisterABI(radius.NewAddress(token.Bytes()), erc721.ABI())
+	decoder.RegisterABI(radius.NewAddress(vault.Bytes()), radius.ABIFromJSON(DepositABI))
+
+	events, err := decoder.DecodeReceipt(receipt)
+	require.NoError(t, err, "Failed to decode receipt")
+	require.Len(t, events, 3, "Unexpected number of events")
+
+	assert.Equal(t, "Transfer", events[0].Name, "Events should be decoded with the ABI of the emitting contract")
+	assert.Equal(t, big.NewInt(7), events[0].Data["tokenId"], "Unexpected token ID")
+
+	assert.Equal(t, "Deposit", events[1].Name, "Events should be decoded with the ABI of the emitting contract")
+	assert.Equal(t, user, events[1].Data["user"], "Unexpected user")
+	assert.Equal(t, big.NewInt(500), events[1].Data["amount"], "Unexpected amount")
+
+	assert.Empty(t, events[2].Data, "Events from unregistered contracts should not be decoded")
+	assert.Equal(t, radius.NewAddress(unknown.Bytes()), events[2].Address, "Unexpected event address")
+}
+
+func TestDecodeEvents(t *testing.T) {
+	// transfer is a Transfer event with the sender in a Radius address field, and the recipient in a go-ethereum
+	// address field matched by its abi tag
+	type transfer struct {
+		From      radius.Address
+		Recipient common.Address `abi:"to"`
+		Value     *big.Int
+	}
+
+	hashOf := func(h common.Hash) radius.Hash {
+		hash, err := radius.HashFromHex(h.Hex())
+		require.NoError(t, err, "Failed to parse hash")
+		return hash
+	}
+	transferEvent := func(from, to common.Address, value int64) radius.Event {
+		return radius.Event{
+			Topics: []radius.Hash{
+				hashOf(crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))),
+				hashOf(common.BytesToHash(from.Bytes())),
+				hashOf(common.BytesToHash(to.Bytes())),
+			},
+			Raw: common.BigToHash(big.NewInt(value)).Bytes(),
+		}
+	}
+
+	alice := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	bob := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	approval := radius.Event{
+		Topics: []radius.Hash{hashOf(crypto.Keccak256Hash([]byte("Approval(address,address,uint256)")))},
+	}
+	events := []radius.Event{transferEvent(alice, bob, 100), approval, transferEvent(bob, alice, 40)}
+
+	transfers, err := radius.DecodeEvents[transfer](events, erc20.ABI(), "Transfer")
+	require.NoError(t, err, "Failed to decode events")
+	assert.Equal(t, []transfer{
+		{From: radius.NewAddress(alice.Bytes()), Recipient: bob, Value: big.NewInt(100)},
+		{From: radius.NewAddress(bob.Bytes()), Recipient: alice, Value: big.NewInt(40)},
+	}, transfers, "Other events should be skipped")
+
+	_, err = radius.DecodeEvents[struct{ Value string }](events, erc20.ABI(), "Transfer")
+	assert.ErrorContains(t, err, "failed to store argument value", "Mismatched field types should be rejected")
+
+	_, err = radius.DecodeEvents[transfer](events, erc20.ABI(), "Deposit")
+	assert.ErrorContains(t, err, "event Deposit not found in ABI", "Unknown events should be rejected")
+}
+
+func TestClient_WaitForReceipts(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	var hashes []radius.Hash
+	for i := 1; i <= 4; i++ {
+		receipt, err := account.SendWith(context.Background(), client, recipient, big.NewInt(int64(i)))
+		require.NoError(t, err, "Failed to send transaction")
+		hashes = append(hashes, receipt.TxHash)
+	}
+
+	// Delay each receipt so concurrent requests overlap, and track the maximum number of requests in flight
+	var inFlight, maxInFlight atomic.Int32
+	accept := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			highest := maxInFlight.Load()
+			if current <= highest || maxInFlight.CompareAndSwap(highest, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		return accept(params)
+	})
+
+	receipts, err := client.WaitForReceipts(context.Background(), hashes)
+	require.NoError(t, err, "Failed to wait for receipts")
+	require.Len(t, receipts, len(hashes), "Unexpected number of receipts")
+	for i, receipt := range receipts {
+		assert.Equal(t, hashes[i], receipt.TxHash, "Receipts should be returned in the order of the hashes")
+		assert.Equal(t, big.NewInt(int64(i+1)), receipt.Value, "Unexpected receipt value")
+	}
+	assert.Greater(t, maxInFlight.Load(), int32(1), "Receipts should be waited for in parallel")
+	assert.True(t, receipts.AllSucceeded(), "All transactions should succeed")
+	assert.Equal(t, uint64(4*21000), receipts.TotalGasUsed(), "Unexpected total gas used")
+
+	missing, err := radius.HashFromHex(common.HexToHash("0xdead").Hex())
+	require.NoError(t, err, "Failed to parse hash")
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	receipts, err = client.WaitForReceipts(ctx, []radius.Hash{hashes[0], missing})
+	assert.ErrorContains(t, err, missing.Hex(), "Errors should identify the transaction")
+	require.Len(t, receipts, 2, "Unexpected number of receipts")
+	assert.NotNil(t, receipts[0], "Mined receipts should be returned with the errors")
+	assert.Nil(t, receipts[1], "Receipts that could not be waited for should be nil")
+	assert.False(t, receipts.AllSucceeded(), "Batches with missing receipts should not succeed")
+}
+
+func TestTxTracker_Reconcile(t *testing.T) {
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t, radius.WithPollInterval(10*time.Millisecond))
+	account := CreateTestAccount(t, client)
+
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	// Only return receipts for mined transactions, and fail the transaction with nonce 1
+	var mined sync.Map
+	accept := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+		nonce, ok := mined.Load(hash)
+		if !ok {
+			return nil, nil
+		}
+
+		receipt, err := accept(params)
+		if err == nil && nonce.(uint64) == 1 {
+			receipt.(*types.Receipt).Status = types.ReceiptStatusFailed
+		}
+		return receipt, err
+	})
+
+	type result struct {
+		hash    radius.Hash
+		receipt *radius.Receipt
+		err     error
+	}
+	results := make(chan result, 3)
+	tracker := radius.NewTxTracker(client, func(hash radius.Hash, receipt *radius.Receipt, err error) {
+		results <- result{hash: hash, receipt: receipt, err: err}
+	})
+
+	var hashes []radius.Hash
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx, err := account.Signer.SignTransaction(radius.NewTransaction(nonce, &recipient, big.NewInt(1), 21000, nil, nil))
+		require.NoError(t, err, "Failed to sign transaction")
+
+		hash, err := tracker.SendTxTracked(context.Background(), tx, account.Signer)
+		require.NoError(t, err, "Failed to send transaction")
+		hashes = append(hashes, hash)
+	}
+	assert.Len(t, server.SentTransactions(), 3, "Transactions should be sent")
+	assert.Equal(t, 3, tracker.Pending(), "Sent transactions should be tracked")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- tracker.Reconcile(ctx) }()
+
+	for nonce, hash := range hashes {
+		mined.Store(common.BytesToHash(hash.Bytes()), uint64(nonce))
+
+		select {
+		case r := <-results:
+			assert.Equal(t, hash, r.hash, "Callbacks should fire as receipts arrive")
+			require.NotNil(t, r.receipt, "Callbacks should receive the receipt")
+			assert.Equal(t, account.Address(), r.receipt.From, "Unexpected sender")
+			if nonce == 1 {
+				assert.ErrorContains(t, r.err, "transaction failed", "Failed transactions should be reported")
+			} else {
+				assert.NoError(t, r.err, "Successful transactions should not report an error")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for the callback of transaction %d", nonce)
+		}
+	}
+	assert.Zero(t, tracker.Pending(), "Mined transactions should no longer be tracked")
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled, "Reconcile should stop when the context is done")
+	assert.Empty(t, results, "Callbacks should fire once per transaction")
+}
+
+func TestTxQueue_Run(t *testing.T) {
+	const (
+		count    = 5
+		interval = 30 * time.Millisecond
+	)
+
+	server := NewMockServer(t)
+	server.HandleTransactions()
+	client := server.NewClient(t)
+	account := CreateTestAccount(t, client)
+
+	recipient, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse recipient address")
+
+	// Record when each transaction is sent, and hold back receipts until released
+	var (
+		mu     sync.Mutex
+		sentAt []time.Time
+	)
+	send := server.Handler("eth_sendRawTransaction")
+	server.Handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		mu.Lock()
+		sentAt = append(sentAt, time.Now())
+		mu.Unlock()
+		return send(params)
+	})
+	release := make(chan struct{})
+	receipt := server.Handler("eth_getTransactionReceipt")
+	server.Handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		<-release
+		return receipt(params)
+	})
+
+	queue := radius.NewTxQueue(client, account.Signer, radius.TxQueueOptions{MaxInFlight: 2, Interval: interval})
+	var results []<-chan radius.TxResult
+	for i := 0; i < count; i++ {
+		results = append(results, queue.Submit(&recipient, big.NewInt(int64(i+1)), nil))
+	}
+	assert.Equal(t, count, queue.Len(), "Submitted transactions should be queued")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error)
+	go func() { done <- queue.Run(ctx) }()
+
+	time.Sleep(5 * interval)
+	assert.Len(t, server.SentTransactions(), 2, "No more than MaxInFlight transactions should be waiting to be mined")
+	close(release)
+
+	for i, result := range results {
+		select {
+		case r := <-result:
+			require.NoError(t, r.Err, "Transaction %d should succeed", i)
+			require.NotNil(t, r.Receipt, "Transaction %d should have a receipt", i)
+			assert.Equal(t, big.NewInt(int64(i+1)), r.Receipt.Value, "Results should be delivered for each transaction")
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for the result of transaction %d", i)
+		}
+	}
+	assert.Zero(t, queue.Len(), "Sent transactions should no longer be queued")
+
+	sent := server.SentTransactions()
+	require.Len(t, sent, count, "Unexpected number of transactions")
+	for i, tx := range sent {
+		assert.Equal(t, uint64(i), tx.Nonce(), "Transactions should be sent in nonce order")
+		assert.Equal(t, big.NewInt(int64(i+1)), tx.Value(), "Transactions should be sent in submission order")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for i := 1; i < len(sentAt); i++ {
+		assert.GreaterOrEqual(t, sentAt[i].Sub(sentAt[i-1]), interval, "Transactions should be sent at most once per interval")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled, "Run should stop when the context is done")
+}
+
+func TestClient_TransactionStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		mined   bool
+		known   bool
+		nonce   string
+		want    radius.TxState
+		wantStr string
+	}{
+		{name: "mined", mined: true, known: true, nonce: "0x1", want: radius.TxStateMined, wantStr: "mined"},
+		{name: "pending", known: true, nonce: "0x0", want: radius.TxStatePending, wantStr: "pending"},
+		{name: "replaced", known: true, nonce: "0x1", want: radius.TxStateDropped, wantStr: "dropped"},
+		{name: "evicted", nonce: "0x0", want: radius.TxStateDropped, wantStr: "dropped"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewMockServer(t)
+			server.HandleTransactions()
+			client := server.NewClient(t)
+			account := CreateTestAccount(t, client)
+
+			recipient, err := radius.AddressFromHex(MockContractAddress)
+			require.NoError(t, err, "Failed to parse recipient address")
+			tx, err := account.Signer.SignTransaction(radius.NewTransaction(0, &recipient, big.NewInt(1), 21000, nil, nil))
+			require.NoError(t, err, "Failed to sign transaction")
+			hash, err := client.SendRawTransaction(context.Background(), tx.Serialized)
+			require.NoError(t, err, "Failed to send transaction")
+
+			if !tt.mined {
+				server.HandleResult("eth_getTransactionReceipt", nil)
+			}
+			if !tt.known {
+				server.HandleResult("eth_getTransactionByHash", nil)
+			}
+			server.HandleResult("eth_getTransactionCount", tt.nonce)
+
+			state, err := client.TransactionStatus(context.Background(), hash)
+			require.NoError(t, err, "Failed to get transaction status")
+			assert.Equal(t, tt.want, state, "Unexpected transaction state")
+			assert.Equal(t, tt.wantStr, state.String(), "Unexpected transaction state name")
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/radiustechsystems/sdk/go/radius"
+	"github.com/radiustechsystems/sdk/go/radius/siwe"
+)
+
+// siweExample is the example message from EIP-4361
+const siweExample = `service.invalid wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.invalid/tos
+
+URI: https://service.invalid/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestSIWE_BuildMessage(t *testing.T) {
+	address, err := radius.AddressFromHex("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	require.NoError(t, err, "Failed to parse address")
+
+	params := siwe.Params{
+		Domain:    "service.invalid",
+		Address:   address,
+		Statement: "I accept the ServiceOrg Terms of Service: https://service.invalid/tos",
+		URI:       "https://service.invalid/login",
+		ChainID:   big.NewInt(1),
+		Nonce:     "32891756",
+		IssuedAt:  time.Date(2021, 9, 30, 16, 25, 24, 0, time.UTC),
+		Resources: []string{
+			"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/",
+			"https://example.com/my-web2-claim.json",
+		},
+	}
+
+	message, err := siwe.BuildMessage(params)
+	require.NoError(t, err, "Failed to build message")
+	assert.Equal(t, siweExample, message, "Message should match the EIP-4361 example")
+
+	parsed, err := siwe.ParseMessage(siweExample)
+	require.NoError(t, err, "Failed to parse message")
+	params.Version = siwe.Version
+	assert.Equal(t, params, parsed, "Parsed message should match the parameters")
+
+	// Without a statement, the statement line is omitted but the surrounding blank lines are kept
+	params.Statement = ""
+	params.Resources = nil
+	params.ExpirationTime = params.IssuedAt.Add(time.Hour)
+	message, err = siwe.BuildMessage(params)
+	require.NoError(t, err, "Failed to build message without statement")
+	assert.Equal(t, `service.invalid wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+
+URI: https://service.invalid/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Expiration Time: 2021-09-30T17:25:24Z`, message, "Unexpected message without statement")
+
+	parsed, err = siwe.ParseMessage(message)
+	require.NoError(t, err, "Failed to parse message without statement")
+	assert.Equal(t, params, parsed, "Parsed message should match the parameters")
+
+	invalid := []struct {
+		name   string
+		modify func(p *siwe.Params)
+	}{
+		{name: "short nonce", modify: func(p *siwe.Params) { p.Nonce = "1234" }},
+		{name: "non-alphanumeric nonce", modify: func(p *siwe.Params) { p.Nonce = "1234-5678" }},
+		{name: "missing domain", modify: func(p *siwe.Params) { p.Domain = "" }},
+		{name: "missing URI", modify: func(p *siwe.Params) { p.URI = "" }},
+		{name: "multiline statement", modify: func(p *siwe.Params) { p.Statement = "a\nb" }},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			p := params
+			tt.modify(&p)
+			_, err := siwe.BuildMessage(p)
+			assert.Error(t, err, "Invalid parameters should be rejected")
+		})
+	}
+}
+
+func TestSIWE_SignAndVerify(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err, "Failed to generate private key")
+	account := radius.NewAccount(radius.WithSigner(radius.NewKeySignerWithChainID(key, big.NewInt(1234))))
+
+	params := siwe.Params{
+		Domain:         "example.com",
+		URI:            "https://example.com/login",
+		ChainID:        big.NewInt(1234),
+		Nonce:          "abcdef0123456789",
+		IssuedAt:       time.Now().Add(-time.Minute).UTC().Truncate(time.Second),
+		ExpirationTime: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+	}
+
+	message, signature, err := account.SignSIWE(params)
+	require.NoError(t, err, "Failed to sign message")
+	assert.True(t, strings.HasPrefix(message, "example.com wants you to sign in"), "Unexpected message")
+
+	address, err := siwe.Verify(message, signature)
+	require.NoError(t, err, "Failed to verify message")
+	assert.Equal(t, account.Address(), address, "Unexpected signer")
+
+	// Wallets commonly return signatures with a recovery id of 27 or 28
+	walletSignature := append([]byte(nil), signature...)
+	walletSignature[64] += 27
+	_, err = siwe.Verify(message, walletSignature)
+	assert.NoError(t, err, "Signatures with an offset recovery id should be verified")
+
+	tampered := strings.Replace(message, "Nonce: abcdef0123456789", "Nonce: abcdef0123456780", 1)
+	_, err = siwe.Verify(tampered, signature)
+	assert.Error(t, err, "Tampered messages should not be verified")
+
+	// The signature is valid, but EIP-4361 requires the address to be EIP-55 checksummed
+	checksummed := account.Address()
+	lowercase := strings.Replace(message, checksummed.Hex(), strings.ToLower(checksummed.Hex()), 1)
+	lowercaseSignature, err := account.SignMessage([]byte(lowercase))
+	require.NoError(t, err, "Failed to sign message")
+	_, err = siwe.Verify(lowercase, lowercaseSignature)
+	assert.ErrorContains(t, err, "not EIP-55 checksummed", "Addresses without a checksum should not be verified")
+
+	params.ExpirationTime = time.Now().Add(-time.Second).UTC()
+	expired, signature, err := account.SignSIWE(params)
+	require.NoError(t, err, "Failed to sign expired message")
+	_, err = siwe.Verify(expired, signature)
+	assert.ErrorContains(t, err, "expired", "Expired messages should not be verified")
+
+	other, err := radius.AddressFromHex(MockContractAddress)
+	require.NoError(t, err, "Failed to parse address")
+	params.Address = other
+	_, _, err = account.SignSIWE(params)
+	assert.Error(t, err, "Messages for other addresses should not be signed")
+}